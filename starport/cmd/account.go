@@ -16,6 +16,7 @@ const (
 	flagNonInteractive = "non-interactive"
 	flagKeyringBackend = "keyring-backend"
 	flagFrom           = "from"
+	flagFromFile       = "from-file"
 )
 
 func NewAccount() *cobra.Command {
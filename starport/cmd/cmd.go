@@ -2,6 +2,7 @@ package starportcmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -22,6 +23,7 @@ import (
 	"github.com/tendermint/starport/starport/pkg/goenv"
 	"github.com/tendermint/starport/starport/pkg/xgenny"
 	"github.com/tendermint/starport/starport/services/chain"
+	"github.com/tendermint/starport/starport/services/network"
 	"github.com/tendermint/starport/starport/services/scaffolder"
 )
 
@@ -34,8 +36,30 @@ const (
 	checkVersionTimeout = time.Millisecond * 600
 )
 
+const (
+	exitCodeError    = 1
+	exitCodeNotFound = 3
+	exitCodeEmpty    = 4
+)
+
 var infoColor = color.New(color.FgYellow).SprintFunc()
 
+// ExitCode returns the exit code of the process for the error returned by a command.
+func ExitCode(err error) int {
+	var (
+		notFoundErr        network.LaunchNotFoundError
+		chainIDNotFoundErr network.ChainIDNotFoundError
+	)
+	if errors.As(err, &notFoundErr) || errors.As(err, &chainIDNotFoundErr) {
+		return exitCodeNotFound
+	}
+	var emptyErr emptyLaunchError
+	if errors.As(err, &emptyErr) {
+		return exitCodeEmpty
+	}
+	return exitCodeError
+}
+
 // New creates a new root command for `starport` with its sub commands.
 func New(ctx context.Context) *cobra.Command {
 	cobra.EnableCommandSorting = false
@@ -81,10 +105,18 @@ func logLevel(cmd *cobra.Command) chain.LogLvl {
 	return chain.LogRegular
 }
 
+// printEvents shows the events on the spinner, the completed events are written
+// to stderr and the ongoing ones are dropped when there is no spinner.
 func printEvents(wg *sync.WaitGroup, bus events.Bus, s *clispinner.Spinner) {
 	defer wg.Done()
 
 	for event := range bus {
+		if s == nil {
+			if !event.IsOngoing() {
+				fmt.Fprintf(os.Stderr, "%s %s\n", clispinner.OK, event.Description)
+			}
+			continue
+		}
 		if event.IsOngoing() {
 			s.SetText(event.Text())
 			s.Start()
@@ -113,6 +145,7 @@ func flagSetHome() *flag.FlagSet {
 func flagNetworkFrom() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 	fs.String(flagFrom, cosmosaccount.DefaultAccount, "Account name to use for sending transactions to SPN")
+	fs.String(flagFromFile, "", "Path of a file holding the name or the address of the account to use instead of --from")
 	return fs
 }
 
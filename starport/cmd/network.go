@@ -1,8 +1,16 @@
 package starportcmd
 
 import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
 	"sync"
+	"time"
 
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/tendermint/starport/starport/pkg/clispinner"
@@ -20,6 +28,11 @@ var (
 
 	spnNodeAddress   string
 	spnFaucetAddress string
+
+	spnInsecure      bool
+	spnTLSCA         string
+	spnTLSSkipVerify bool
+	spnKeepAlive     time.Duration
 )
 
 const (
@@ -28,6 +41,16 @@ const (
 
 	flagSPNNodeAddress   = "spn-node-address"
 	flagSPNFaucetAddress = "spn-faucet-address"
+	flagInsecure         = "insecure"
+	flagTLSCA            = "tls-ca"
+	flagTLSSkipVerify    = "tls-skip-verify"
+	flagKeepAlive        = "keepalive"
+
+	// defaultKeepAlive keeps the SPN connection alive during the long --watch sessions.
+	defaultKeepAlive = 30 * time.Second
+
+	// envSPNNodeAddress is the default SPN node address when --spn-node-address isn't set.
+	envSPNNodeAddress = "STARPORT_SPN_ADDRESS"
 
 	spnNodeAddressAlpha   = "https://rpc.alpha.starport.network:443"
 	spnFaucetAddressAlpha = "https://faucet.alpha.starport.network"
@@ -53,13 +76,29 @@ func NewNetwork() *cobra.Command {
 	// configure flags.
 	c.PersistentFlags().BoolVar(&local, flagLocal, false, "Use local SPN network")
 	c.PersistentFlags().BoolVar(&nightly, flagNightly, false, "Use nightly SPN network")
-	c.PersistentFlags().StringVar(&spnNodeAddress, flagSPNNodeAddress, spnNodeAddressAlpha, "SPN node address")
+	c.PersistentFlags().StringVar(
+		&spnNodeAddress,
+		flagSPNNodeAddress,
+		spnNodeAddressAlpha,
+		"SPN node address, defaults to $"+envSPNNodeAddress+" when set",
+	)
 	c.PersistentFlags().StringVar(&spnFaucetAddress, flagSPNFaucetAddress, spnFaucetAddressAlpha, "SPN Faucet address")
+	c.PersistentFlags().BoolVar(&spnInsecure, flagInsecure, false, "Reach the SPN node over plaintext http")
+	c.PersistentFlags().StringVar(&spnTLSCA, flagTLSCA, "", "Path of the PEM encoded CA certificate used to verify the SPN node")
+	c.PersistentFlags().BoolVar(&spnTLSSkipVerify, flagTLSSkipVerify, false, "Skip the verification of the SPN node certificate")
+	c.PersistentFlags().DurationVar(
+		&spnKeepAlive,
+		flagKeepAlive,
+		defaultKeepAlive,
+		"Period of the keep-alive probes of the idle SPN connection, 0 for the system default",
+	)
 
 	// add sub commands.
 	c.AddCommand(
 		NewNetworkChain(),
 		NewNetworkRequest(),
+		NewNetworkCoordinator(),
+		NewNetworkHealth(),
 	)
 
 	return c
@@ -77,7 +116,39 @@ type NetworkBuilder struct {
 	cc  cosmosclient.Client
 }
 
-func newNetworkBuilder(cmd *cobra.Command) (NetworkBuilder, error) {
+// StopSpinner stops the spinner if it is enabled.
+func (n NetworkBuilder) StopSpinner() {
+	if n.Spinner != nil {
+		n.Spinner.Stop()
+	}
+}
+
+// StartSpinner starts the spinner if it is enabled.
+func (n NetworkBuilder) StartSpinner() {
+	if n.Spinner != nil {
+		n.Spinner.Start()
+	}
+}
+
+// SetSpinnerText sets the text of the spinner if it is enabled.
+func (n NetworkBuilder) SetSpinnerText(text string) {
+	if n.Spinner != nil {
+		n.Spinner.SetText(text)
+	}
+}
+
+// NetworkBuilderOption configures the network builder.
+type NetworkBuilderOption func(*NetworkBuilder)
+
+// WithoutSpinner disables the spinner, the Spinner field is nil and the status of the
+// completed steps is written to stderr to keep stdout for the command output.
+func WithoutSpinner() NetworkBuilderOption {
+	return func(n *NetworkBuilder) {
+		n.Spinner = nil
+	}
+}
+
+func newNetworkBuilder(cmd *cobra.Command, options ...NetworkBuilderOption) (NetworkBuilder, error) {
 	var err error
 
 	n := NetworkBuilder{
@@ -86,6 +157,9 @@ func newNetworkBuilder(cmd *cobra.Command) (NetworkBuilder, error) {
 		wg:      &sync.WaitGroup{},
 		cmd:     cmd,
 	}
+	for _, apply := range options {
+		apply(&n)
+	}
 
 	n.wg.Add(1)
 	go printEvents(n.wg, n.ev, n.Spinner)
@@ -113,7 +187,7 @@ func (n NetworkBuilder) Chain(source networkchain.SourceOption, options ...netwo
 func (n NetworkBuilder) Network(options ...network.Option) (network.Network, error) {
 	options = append(options, network.CollectEvents(n.ev))
 
-	account, err := cosmos.AccountRegistry.GetByName(getFrom(n.cmd))
+	account, err := getNetworkAccount(n.cmd, cosmos.AccountRegistry)
 	if err != nil {
 		return network.Network{}, errors.Wrap(err, "make sure that this account exists, use 'starport account -h' to manage accounts")
 	}
@@ -122,12 +196,129 @@ func (n NetworkBuilder) Network(options ...network.Option) (network.Network, err
 }
 
 func (n NetworkBuilder) Cleanup() {
-	n.Spinner.Stop()
+	n.StopSpinner()
+	n.cc.Close()
 	n.ev.Shutdown()
 	n.wg.Wait()
 }
 
+// getNetworkAccount returns the account signing the SPN transactions, the account of --from
+// or the account whose name or address is held by the --from-file file.
+func getNetworkAccount(cmd *cobra.Command, registry cosmosaccount.Registry) (cosmosaccount.Account, error) {
+	fromFile, _ := cmd.Flags().GetString(flagFromFile)
+	if fromFile == "" {
+		return registry.GetByName(getFrom(cmd))
+	}
+
+	// the default account doesn't conflict with the file
+	var from string
+	if cmd.Flags().Changed(flagFrom) {
+		from = getFrom(cmd)
+	}
+	return accountFromFile(registry, fromFile, from)
+}
+
+// accountFromFile returns the account whose name or SPN address is held by the file,
+// it fails if from is set and is neither the name nor the address of this account.
+func accountFromFile(registry cosmosaccount.Registry, path, from string) (cosmosaccount.Account, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return cosmosaccount.Account{}, errors.Wrapf(err, "cannot read --%s", flagFromFile)
+	}
+	nameOrAddress := strings.TrimSpace(string(content))
+	if nameOrAddress == "" {
+		return cosmosaccount.Account{}, fmt.Errorf("%s is empty, it must hold an account name or address", path)
+	}
+
+	account, err := findAccount(registry, nameOrAddress)
+	if err != nil {
+		return cosmosaccount.Account{}, err
+	}
+	if from != "" && from != account.Name && from != account.Address(networkchain.SPN) {
+		return cosmosaccount.Account{}, fmt.Errorf("--%s %s and --%s %s disagree, the file holds the account %s",
+			flagFrom,
+			from,
+			flagFromFile,
+			path,
+			account.Name,
+		)
+	}
+	return account, nil
+}
+
+// findAccount returns the account of the registry with the name or the SPN address.
+func findAccount(registry cosmosaccount.Registry, nameOrAddress string) (cosmosaccount.Account, error) {
+	if _, _, err := bech32.DecodeAndConvert(nameOrAddress); err != nil {
+		return registry.GetByName(nameOrAddress)
+	}
+
+	accounts, err := registry.List()
+	if err != nil {
+		return cosmosaccount.Account{}, err
+	}
+	for _, acc := range accounts {
+		if acc.Address(networkchain.SPN) == nameOrAddress {
+			return acc, nil
+		}
+	}
+	return cosmosaccount.Account{}, fmt.Errorf("no account of the keyring has the address %s", nameOrAddress)
+}
+
+// getSPNNodeAddress returns the SPN node address from the flag if set explicitly,
+// from the environment otherwise and falls back to the flag default.
+func getSPNNodeAddress(cmd *cobra.Command) string {
+	if cmd.Flags().Changed(flagSPNNodeAddress) {
+		return spnNodeAddress
+	}
+	if address := os.Getenv(envSPNNodeAddress); address != "" {
+		return address
+	}
+	return spnNodeAddress
+}
+
+// getSPNTransport returns the SPN node address and the TLS configuration to reach it from the transport flags.
+// The node address uses the http scheme with --insecure and the TLS configuration is nil without TLS flags.
+func getSPNTransport(nodeAddress string) (string, *tls.Config, error) {
+	if spnInsecure && (spnTLSCA != "" || spnTLSSkipVerify) {
+		return "", nil, fmt.Errorf("--%s can't be combined with --%s or --%s", flagInsecure, flagTLSCA, flagTLSSkipVerify)
+	}
+	if spnTLSCA != "" && spnTLSSkipVerify {
+		return "", nil, fmt.Errorf("--%s can't be combined with --%s", flagTLSCA, flagTLSSkipVerify)
+	}
+
+	if spnInsecure {
+		u, err := url.Parse(nodeAddress)
+		if err != nil {
+			return "", nil, errors.Wrap(err, "invalid SPN node address")
+		}
+		if u.Scheme == "https" {
+			u.Scheme = "http"
+		}
+		return u.String(), nil, nil
+	}
+
+	switch {
+	case spnTLSSkipVerify:
+		return nodeAddress, &tls.Config{InsecureSkipVerify: true}, nil // nolint:gosec
+	case spnTLSCA != "":
+		ca, err := os.ReadFile(spnTLSCA)
+		if err != nil {
+			return "", nil, errors.Wrap(err, "cannot read the TLS CA")
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return "", nil, fmt.Errorf("no PEM encoded certificate found in %s", spnTLSCA)
+		}
+		return nodeAddress, &tls.Config{RootCAs: pool}, nil
+	}
+	return nodeAddress, nil, nil
+}
+
 func getNetworkCosmosClient(cmd *cobra.Command) (cosmosclient.Client, error) {
+	// the SPN node address is picked in the following order:
+	// --local or --nightly, --spn-node-address, $STARPORT_SPN_ADDRESS, then the alpha network.
+	spnNodeAddress = getSPNNodeAddress(cmd)
+
 	// check preconfigured networks
 	if nightly && local {
 		return cosmosclient.Client{}, errors.New("local and nightly networks can't be specified in the same command")
@@ -140,9 +331,16 @@ func getNetworkCosmosClient(cmd *cobra.Command) (cosmosclient.Client, error) {
 		spnFaucetAddress = spnFaucetAddressNightly
 	}
 
+	nodeAddress, tlsConfig, err := getSPNTransport(spnNodeAddress)
+	if err != nil {
+		return cosmosclient.Client{}, err
+	}
+
 	cosmosOptions := []cosmosclient.Option{
 		cosmosclient.WithHome(cosmosaccount.KeyringHome),
-		cosmosclient.WithNodeAddress(spnNodeAddress),
+		cosmosclient.WithNodeAddress(nodeAddress),
+		cosmosclient.WithTLSConfig(tlsConfig),
+		cosmosclient.WithKeepAlive(spnKeepAlive),
 		cosmosclient.WithAddressPrefix(networkchain.SPN),
 		cosmosclient.WithUseFaucet(spnFaucetAddress, networkchain.SPNDenom, 5),
 		cosmosclient.WithKeyringServiceName(cosmosaccount.KeyringServiceName),
@@ -19,6 +19,7 @@ func NewNetworkChain() *cobra.Command {
 		NewNetworkChainJoin(),
 		NewNetworkChainPrepare(),
 		NewNetworkChainLaunch(),
+		NewNetworkChainShow(),
 	)
 
 	return c
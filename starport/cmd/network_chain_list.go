@@ -4,14 +4,18 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strconv"
 
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
-	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
 	"github.com/tendermint/starport/starport/pkg/entrywriter"
+	"github.com/tendermint/starport/starport/services/network"
 	"github.com/tendermint/starport/starport/services/network/networktypes"
 )
 
-var LaunchSummaryHeader = []string{"launch ID", "chain ID", "source", "campaign ID"}
+const flagMine = "mine"
+
+var LaunchSummaryHeader = []string{"Launch ID", "Chain ID", "Source URL", "Launched"}
 
 // LaunchSummary holds summarized information about a chain launch
 type LaunchSummary struct {
@@ -29,7 +33,10 @@ func NewNetworkChainList() *cobra.Command {
 		Args:  cobra.NoArgs,
 		RunE:  networkChainListHandler,
 	}
-	c.Flags().String(flagFrom, cosmosaccount.DefaultAccount, "Account name to use for sending transactions to SPN")
+	c.Flags().Uint64(flagLimit, 0, "Maximum number of chains to list, 0 lists all of them")
+	c.Flags().Uint64(flagOffset, 0, "Number of chains to skip before listing them")
+	c.Flags().Bool(flagMine, false, "List only the chains coordinated by the account")
+	c.Flags().AddFlagSet(flagNetworkFrom())
 	c.Flags().AddFlagSet(flagSetKeyringBackend())
 	c.Flags().AddFlagSet(flagSetHome())
 
@@ -37,14 +44,18 @@ func NewNetworkChainList() *cobra.Command {
 }
 
 func networkChainListHandler(cmd *cobra.Command, args []string) error {
+	var (
+		limit, _  = cmd.Flags().GetUint64(flagLimit)
+		offset, _ = cmd.Flags().GetUint64(flagOffset)
+		mine, _   = cmd.Flags().GetBool(flagMine)
+	)
+
 	nb, err := newNetworkBuilder(cmd)
 	if err != nil {
 		return err
 	}
 	defer nb.Cleanup()
 
-	nb.Spinner.Stop()
-
 	n, err := nb.Network()
 	if err != nil {
 		return err
@@ -53,7 +64,44 @@ func networkChainListHandler(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
-	return renderLaunchSummaries(chainLaunches, os.Stdout)
+
+	if mine {
+		coordinatorID, err := n.CoordinatorID(cmd.Context())
+		if errors.Is(err, network.ErrNotCoordinator) {
+			// an account that is not a coordinator has no chain
+			chainLaunches = nil
+		} else if err != nil {
+			return err
+		}
+		chainLaunches = filterCoordinatorLaunches(chainLaunches, coordinatorID)
+	}
+
+	nb.Spinner.Stop()
+
+	return renderLaunchSummaries(paginateLaunches(chainLaunches, offset, limit), os.Stdout)
+}
+
+// filterCoordinatorLaunches returns the chain launches coordinated by the coordinator.
+func filterCoordinatorLaunches(chainLaunches []networktypes.ChainLaunch, coordinatorID uint64) []networktypes.ChainLaunch {
+	var filtered []networktypes.ChainLaunch
+	for _, c := range chainLaunches {
+		if c.CoordinatorID == coordinatorID {
+			filtered = append(filtered, c)
+		}
+	}
+	return filtered
+}
+
+// paginateLaunches returns the chain launches of the page starting at offset, a zero limit returns all of them.
+func paginateLaunches(chainLaunches []networktypes.ChainLaunch, offset, limit uint64) []networktypes.ChainLaunch {
+	if offset >= uint64(len(chainLaunches)) {
+		return nil
+	}
+	chainLaunches = chainLaunches[offset:]
+	if limit > 0 && limit < uint64(len(chainLaunches)) {
+		chainLaunches = chainLaunches[:limit]
+	}
+	return chainLaunches
 }
 
 // renderLaunchSummaries writes into the provided out, the list of summarized launches
@@ -61,16 +109,11 @@ func renderLaunchSummaries(chainLaunches []networktypes.ChainLaunch, out io.Writ
 	var launchEntries [][]string
 
 	for _, c := range chainLaunches {
-		campaign := "no campaign"
-		if c.CampaignID > 0 {
-			campaign = fmt.Sprintf("%d", c.CampaignID)
-		}
-
 		launchEntries = append(launchEntries, []string{
 			fmt.Sprintf("%d", c.ID),
 			c.ChainID,
 			c.SourceURL,
-			campaign,
+			strconv.FormatBool(c.LaunchTriggered),
 		})
 	}
 
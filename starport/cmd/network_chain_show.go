@@ -0,0 +1,5658 @@
+package starportcmd
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"text/template"
+	"time"
+	"unicode"
+
+	"github.com/cenkalti/backoff"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/fatih/color"
+	"github.com/manifoldco/promptui"
+	"github.com/pelletier/go-toml"
+	"github.com/pkg/errors"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/spf13/cobra"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+	"github.com/tendermint/starport/starport/chainconfig"
+	"github.com/tendermint/starport/starport/pkg/clipboard"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
+	"github.com/tendermint/starport/starport/pkg/cosmosutil"
+	"github.com/tendermint/starport/starport/pkg/ctxticker"
+	"github.com/tendermint/starport/starport/pkg/entrywriter"
+	"github.com/tendermint/starport/starport/pkg/jsonpath"
+	"github.com/tendermint/starport/starport/pkg/ratelimit"
+	"github.com/tendermint/starport/starport/pkg/tendermintrpc"
+	"github.com/tendermint/starport/starport/pkg/yaml"
+	"github.com/tendermint/starport/starport/services/network"
+	"github.com/tendermint/starport/starport/services/network/networkchain"
+	"github.com/tendermint/starport/starport/services/network/networktypes"
+	tmlog "github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/p2p/pex"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/term"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ShowType represents the kind of chain details shown by the chain show command.
+type ShowType string
+
+const (
+	flagOut                = "out"
+	flagForce              = "force"
+	flagLimit              = "limit"
+	flagOffset             = "offset"
+	flagDenom              = "denom"
+	flagTotals             = "totals"
+	flagWatch              = "watch"
+	flagInterval           = "interval"
+	flagFormat             = "format"
+	flagCSV                = "csv"
+	flagSortBy             = "sort-by"
+	flagRedact             = "redact"
+	flagValidator          = "validator"
+	flagValidate           = "validate"
+	flagSummary            = "summary"
+	flagVesting            = "vesting"
+	flagPeerFormat         = "peer-format"
+	flagCheck              = "check"
+	flagGenesisURL         = "genesis-url"
+	flagLive               = "live"
+	flagRetries            = "retries"
+	flagTimeout            = "timeout"
+	flagAddrbook           = "addrbook"
+	flagWide               = "wide"
+	flagNoHeader           = "no-header"
+	flagFromRPC            = "from-rpc"
+	flagDiff               = "diff"
+	flagResolveCoordinator = "resolve-coordinator"
+	flagLogLevel           = "log-level"
+	flagDenoms             = "denoms"
+	flagHighlightMine      = "highlight-mine"
+	flagFields             = "fields"
+	flagRaw                = "raw"
+	flagConcurrency        = "concurrency"
+	flagCounts             = "counts"
+	flagQuiet              = "quiet"
+	flagByChainID          = "by-chain-id"
+	flagBech32Prefix       = "bech32-prefix"
+	flagExplain            = "explain"
+	flagStream             = "stream"
+	flagRequireNonEmpty    = "require-nonempty"
+	flagOffline            = "offline"
+	flagGentxCount         = "gentx-count"
+	flagTemplate           = "template"
+	flagMaxRate            = "max-rate"
+	flagColumns            = "columns"
+	flagCheckDupes         = "check-dupes"
+	flagExportDir          = "export-dir"
+	flagHumanize           = "humanize"
+	flagSI                 = "si"
+	flagAddressMatch       = "address-match"
+	flagMergeConfig        = "merge-config"
+	flagWrite              = "write"
+	flagChecksum           = "checksum"
+	flagContinueOnError    = "continue-on-error"
+	flagMaxColumnWidth     = "max-column-width"
+	flagStatus             = "status"
+	flagCopy               = "copy"
+	flagVerifyKeys         = "verify-keys"
+	flagPollUntilLaunched  = "poll-until-launched"
+	flagGroupBy            = "group-by"
+	flagStrict             = "strict"
+	flagAnnotateMine       = "annotate-mine"
+	flagGzip               = "gzip"
+	flagAuditVesting       = "audit-vesting"
+	flagLocale             = "locale"
+	flagJSONPath           = "json-path"
+	flagCacheTTL           = "cache-ttl"
+	flagNoCache            = "no-cache"
+	flagDiffAgainst        = "diff-against"
+	flagPeerRPC            = "peer-rpc"
+	flagPostProcess        = "post-process"
+	flagPager              = "pager"
+	flagPower              = "power"
+
+	maxLaunchIDRange   = 100
+	defaultConcurrency = 4
+
+	defaultWatchInterval = 5 * time.Second
+	clearScreen          = "\033[H\033[2J"
+
+	outputText     = "text"
+	outputJSON     = "json"
+	outputYAML     = "yaml"
+	outputMarkdown = "markdown"
+	outputNDJSON   = "ndjson"
+
+	peersFormatTOML       = "toml"
+	peersFormatPersistent = "persistent"
+	peersFormatSeeds      = "seeds"
+	peersFormatCSV        = "csv"
+
+	maxGenesisSize = 512 << 20
+
+	// genesisIdleTimeout is how long a genesis transfer waits for data before it is canceled.
+	genesisIdleTimeout = time.Minute
+
+	// genesisConfirmSize is the size above which the genesis is only downloaded once confirmed.
+	genesisConfirmSize = 100 << 20
+
+	defaultRPCPort    = "26657"
+	liveStatusTimeout = 5 * time.Second
+
+	logLevelError = "error"
+	logLevelInfo  = "info"
+	logLevelDebug = "debug"
+
+	defaultRetries = 3
+	defaultTimeout = 30 * time.Second
+
+	defaultCacheTTL = time.Minute
+
+	peerCheckTimeout  = 3 * time.Second
+	peerCheckWorkers  = 10
+	peerStatusUp      = "UP"
+	peerStatusDown    = "DOWN"
+	peerStatusTimeout = "TIMEOUT"
+
+	// vestingTypeDelayed is the vesting type of all the vesting accounts: the launchtypes.VestingOptions
+	// oneof of SPN only has the DelayedVesting option, SPN has no continuous or periodic vesting,
+	// and networktypes.ToVestingAccount fails on any other option.
+	vestingTypeDelayed = "delayed"
+
+	chainShowInfo       ShowType = "info"
+	chainShowGenesis    ShowType = "genesis"
+	chainShowAccounts   ShowType = "accounts"
+	chainShowValidators ShowType = "validators"
+	chainShowPeers      ShowType = "peers"
+	chainShowParams     ShowType = "params"
+	chainShowGentxs     ShowType = "gentxs"
+	chainShowRequests   ShowType = "requests"
+	chainShowAll        ShowType = "all"
+
+	// requestStatusPending is the status of the requests SPN keeps, the settled requests are removed.
+	requestStatusPending = "pending"
+
+	peerKeyMatch    = "ok"
+	peerKeyMismatch = "mismatch"
+	peerKeyUnknown  = "unknown"
+)
+
+var (
+	showTypes = map[ShowType]struct{}{
+		chainShowInfo:       {},
+		chainShowGenesis:    {},
+		chainShowAccounts:   {},
+		chainShowValidators: {},
+		chainShowPeers:      {},
+		chainShowParams:     {},
+		chainShowGentxs:     {},
+		chainShowRequests:   {},
+		chainShowAll:        {},
+	}
+	watchableShowTypes = map[ShowType]struct{}{
+		chainShowAccounts:   {},
+		chainShowValidators: {},
+		chainShowPeers:      {},
+	}
+	yamlShowTypes = map[ShowType]struct{}{
+		chainShowInfo:     {},
+		chainShowAccounts: {},
+		chainShowPeers:    {},
+		chainShowAll:      {},
+	}
+	markdownShowTypes = map[ShowType]struct{}{
+		chainShowAccounts:   {},
+		chainShowValidators: {},
+		chainShowPeers:      {},
+	}
+	ndjsonShowTypes = map[ShowType]struct{}{
+		chainShowAccounts:   {},
+		chainShowValidators: {},
+		chainShowPeers:      {},
+	}
+	outputFormats = []string{outputText, outputJSON, outputYAML, outputMarkdown, outputNDJSON}
+	peersFormats  = []string{peersFormatPersistent, peersFormatSeeds, peersFormatCSV}
+	logLevels     = []string{logLevelError, logLevelInfo, logLevelDebug}
+
+	chainAccSummaryHeader = []string{"Genesis Account", "Coins"}
+	accountsSortColumns   = map[string]int{
+		"address": 0,
+		"coins":   1,
+	}
+	chainAccVestingHeader = []string{"Vesting Type", "Vesting End"}
+	chainAccTotalsHeader  = []string{"Denom", "Total Amount"}
+	chainAccDenomsHeader  = []string{"Denom", "Accounts"}
+	chainAccGroupsHeader  = []string{"Denom", "Accounts", "Total Amount"}
+	chainAccClassesHeader = []string{"Denom", "Class", "Accounts", "Total Amount"}
+	chainAccAuditHeader   = []string{"Vesting Account", "Total Balance", "Vesting", "Issue"}
+	chainAccMineHeader    = "Mine"
+	chainDiffHeader       = []string{"Change", "Address", "From", "To"}
+	chainValSummaryHeader = []string{"Address", "Self Delegation", "Peer", "Gentx Hash"}
+	chainValPowerHeader   = "Power"
+	chainRequestsHeader   = []string{"Request ID", "Type", "Creator", "Status"}
+	chainPeersHeader      = []string{"Moniker", "Node ID", "Address"}
+	chainPeersCheckHeader = []string{"Peer", "Status"}
+	chainPeerKeysHeader   = []string{"Address", "Peer Node ID", "Gentx Node ID", "Status"}
+)
+
+// NewNetworkChainShow creates a new chain show command to show
+// a chain details on SPN.
+func NewNetworkChainShow() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "show [info|genesis|accounts|validators|gentxs|peers|params|requests|all] [launch-id]",
+		Short: "Show details of a chain",
+		Long: `Show details of a chain published on SPN. The first argument selects what to show:
+
+info:       the launch information of the chain
+genesis:    the genesis file of the chain, the chain must be prepared first
+accounts:   the genesis accounts of the chain
+validators: the genesis validators of the chain with their gentx hash
+gentxs:     the gentxs submitted by the genesis validators of the chain
+peers:      the persistent peers of the chain validators
+params:     the launch params SPN applies to the chain
+requests:   the pending requests to change the genesis of the chain
+all:        the info, accounts, peers and validators of the chain
+
+The info of several chains can be shown at once with a range of launch IDs, e.g. 10-15.
+
+The JSON outputs are wrapped in an envelope holding the schema version, the launch ID, the show
+type and the time the data was fetched along with the data itself.
+
+A chain ID can be used instead of the launch ID, e.g. mychain-1, the launch is then looked
+up on SPN. The arguments containing a letter are always considered as chain IDs.
+
+The genesis can be transformed with --post-process, e.g. --post-process 'jq .app_state.bank'.
+The command is run by the shell with the privileges of the user, never pass a command you
+don't trust.
+
+The command exits with the code 3 if the launch or the chain ID doesn't exist on SPN, 4 if the
+launch has no genesis accounts or validators with --require-nonempty and 1 for any other error.`,
+		Args: cobra.ExactArgs(2),
+		RunE: networkChainShowHandler,
+	}
+
+	c.PersistentFlags().StringP(flagOutput, "o", outputText, "Output format (text|json|yaml|markdown|ndjson)")
+	c.Flags().String(flagOut, "", "Write the genesis into the provided path instead of printing it")
+	c.Flags().Bool(flagGzip, false, "Compress the genesis written with --out, .gz is appended to the path if missing")
+	c.Flags().Bool(flagValidate, false, "Validate the genesis before showing it and report the first invalid field")
+	c.Flags().Bool(flagSummary, false, "Show only the main fields and counts of the genesis instead of the full file")
+	c.Flags().Bool(flagChecksum, false, "Show the SHA256 checksum of the genesis instead of the file, e.g. sha256:<hex>")
+	c.Flags().Bool(flagPager, false, "Show the genesis through $PAGER, less -R by default, when writing into a terminal")
+	c.Flags().String(flagPostProcess, "", "Pipe the genesis through this shell command and show its output, e.g. 'jq .app_state.bank', the command runs with your privileges")
+	c.Flags().String(flagGenesisURL, "", "Show the genesis served at this URL instead of the local chain genesis")
+	c.Flags().String(flagLogLevel, logLevelError, fmt.Sprintf("Level of the SPN queries logs written to stderr (%s)", strings.Join(logLevels, "|")))
+	c.Flags().Bool(flagResolveCoordinator, false, "Show the address of the coordinator, requires an additional query")
+	c.Flags().Bool(flagDiff, false, "Show the differences between the local genesis and the launch information, fails if any")
+	c.Flags().String(flagFromRPC, "", "Show the genesis of the live chain served by this Tendermint RPC endpoint")
+	c.Flags().Int64(flagMaxRate, 0, "Maximum rate in bytes per second of the genesis download with --genesis-url or --from-rpc, 0 for unlimited, the download isn't bounded by --timeout")
+	c.Flags().Bool(flagForce, false, "Overwrite the file provided with --out or --addrbook if it already exists, or the files of a non-empty --export-dir")
+	c.Flags().String(flagExportDir, "", "Write the info, accounts, peers, validators and genesis of the chain into their own files in this directory")
+	c.Flags().Uint64(flagLimit, 0, "Maximum number of accounts or peers to show, 0 shows all of them")
+	c.Flags().Uint64(flagOffset, 0, "Number of accounts or peers to skip before showing them")
+	c.Flags().String(flagDenom, "", "Show only the accounts holding a balance in this denom")
+	c.Flags().String(flagAddressMatch, "", "Show only the accounts whose address, with the --bech32-prefix if set, matches this regular expression")
+	c.Flags().Bool(flagTotals, false, "Show the total amount of each denom held by the genesis accounts")
+	c.Flags().Bool(flagCSV, false, "Print the accounts in the CSV format")
+	c.Flags().Bool(flagGentxCount, false, "Print only the number of gentxs submitted to the launch")
+	c.Flags().Bool(flagOffline, false, "Show the info or the genesis from the chain home and the launch cached by a previous run without contacting SPN")
+	c.Flags().Bool(flagRequireNonEmpty, false, "Fail when the launch has no genesis accounts or validators to show")
+	c.Flags().Bool(flagCheckDupes, false, "List the genesis accounts sharing their address and fail if any")
+	c.Flags().Bool(flagAuditVesting, false, "List the vesting accounts whose vesting coins don't match their balance and fail if any")
+	c.Flags().Uint64(flagDiffAgainst, 0, "Show the genesis accounts or validators added, removed or changed since this base launch")
+	c.Flags().Bool(flagStream, false, "Write the CSV accounts as they are fetched to bound the memory used by large launches")
+	c.Flags().Bool(flagNoHeader, false, "Print the tables without their header line")
+	c.Flags().Int(flagMaxColumnWidth, 0, "Shorten the table cells longer than this width with an ellipsis, 0 disables it, fits the terminal by default")
+	c.Flags().Bool(flagCounts, false, "Show the number of genesis accounts and validators, requires an additional query")
+	c.Flags().Bool(flagByChainID, false, "Look up the launch ID of the chain ID provided as argument on SPN")
+	c.Flags().BoolP(flagQuiet, "q", false, "Disable the spinner and write the status messages to stderr to keep stdout for the output")
+	c.Flags().Int(flagConcurrency, defaultConcurrency, "Number of launches whose info is fetched concurrently with a launch ID range")
+	c.Flags().Bool(flagContinueOnError, false, "Show the info of the other launches of a range when some fail and report the failures at the end")
+	c.Flags().StringSlice(flagFields, nil, "Comma separated list of the chain info fields to show, e.g. ChainID,HomePath")
+	c.Flags().Bool(flagRaw, false, "Show the value of the single field selected with --fields without its key")
+	c.Flags().Bool(flagExplain, false, "Describe each field of the chain info after it")
+	c.Flags().String(flagTemplate, "", "Format the chain info with a Go template, e.g. '{{.ChainID}} @ {{.SourceURL}}'")
+	c.Flags().String(flagJSONPath, "", "Show the values of the JSON chain info matched by a JSONPath, e.g. '$.ChainID'")
+	c.Flags().Bool(flagHighlightMine, false, "Alias of --annotate-mine")
+	c.Flags().Bool(flagAnnotateMine, false, "Add a Mine column to the accounts and validators telling if they are owned by a key of the local keyring as created, whatever the coin type of the chain (true|false)")
+	c.Flags().Bool(flagDenoms, false, "Show only the denoms held by the genesis accounts with the number of accounts holding each")
+	c.Flags().String(flagGroupBy, "", "Group the genesis accounts by denom or by holder class, the balance magnitude of each denom (denom|holder-class)")
+	c.Flags().Bool(flagHumanize, false, "Show the account amounts with thousands separators in the display denom of the local genesis metadata")
+	c.Flags().Bool(flagSI, false, "Show the account amounts with SI suffixes with --humanize, e.g. 1.0T stake")
+	c.Flags().String(flagLocale, "", "Separate the digits of the account amounts shown with --humanize as in the locale, e.g. de")
+	c.Flags().Bool(flagWide, false, "Show the amount of each denom held by the accounts in its own column")
+	c.Flags().Bool(flagVesting, false, "Include the vesting accounts with their vesting type and end time")
+	c.Flags().String(flagBech32Prefix, "", "Show the account addresses with this bech32 prefix, e.g. osmo")
+	c.Flags().String(flagSortBy, "", "Sort the accounts by column (address|coins)")
+	c.Flags().StringSlice(flagColumns, nil, "Comma separated list of the accounts table columns (address|coins|vesting|vesting-end|mine)")
+	c.Flags().Bool(flagRedact, false, "Hide the sensitive fields of the chain info like the home path")
+	c.Flags().Bool(flagLive, false, "Show the latest height of a launched chain queried from the RPC of its validators")
+	c.Flags().String(flagValidator, "", "Show only the gentx of the genesis validator with this address")
+	c.Flags().String(flagStatus, "", "Show only the requests with this status (pending)")
+	c.Flags().Bool(flagWatch, false, "Refresh the accounts, validators or peers periodically until interrupted")
+	c.Flags().Duration(flagInterval, defaultWatchInterval, "Refresh interval used with --watch and --poll-until-launched")
+	c.Flags().Bool(flagPollUntilLaunched, false, "Wait until the launch is triggered before showing the chain, --timeout bounds the wait")
+	c.Flags().Uint64(flagRetries, defaultRetries, "Number of retries of the SPN queries failing with a transient error")
+	c.Flags().Bool(flagStrict, false, "Fail when a section of the genesis information can't be queried instead of showing the other sections")
+	c.Flags().Duration(flagTimeout, defaultTimeout, "Maximum duration of the SPN queries, 0 disables the timeout")
+	c.Flags().Duration(flagCacheTTL, defaultCacheTTL, "Duration the launches and their genesis information are cached on disk, capped to 10s for the launches not triggered yet, 0 disables the cache")
+	c.Flags().Bool(flagNoCache, false, "Query SPN without reading or writing the cache of the launches")
+	c.Flags().String(flagFormat, "", "Format of the peers, use toml to print a config.toml persistent_peers line")
+	c.Flags().Bool(flagPower, false, "Add the share of each validator in the total self delegation with the number of validators holding more than a third of it")
+	c.Flags().Bool(flagPeerRPC, false, "Show the comma separated RPC endpoints of the validators instead of their P2P peers")
+	c.Flags().Bool(flagCheck, false, "Check the peers are reachable and show their status")
+	c.Flags().String(flagAddrbook, "", "Write the peers into an addrbook.json file at this path")
+	c.Flags().String(flagMergeConfig, "", "Merge the peers with the persistent peers of this config.toml and print the merged line")
+	c.Flags().Bool(flagWrite, false, "Write the merged persistent peers into the config.toml of --merge-config")
+	c.Flags().String(flagPeerFormat, "", "Format of the peers (persistent|seeds|csv), persistent and seeds print a config.toml line")
+	c.Flags().Bool(flagCopy, false, "Copy the persistent or seeds peers line into the clipboard in addition to printing it")
+	c.Flags().Bool(flagVerifyKeys, false, "Cross-check the node ID of the peer of each validator against the node ID of its gentx")
+	c.Flags().AddFlagSet(flagNetworkFrom())
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().AddFlagSet(flagSetYes())
+
+	return c
+}
+
+func networkChainShowHandler(cmd *cobra.Command, args []string) error {
+	showType := ShowType(args[0])
+	if _, ok := showTypes[showType]; !ok {
+		return fmt.Errorf("invalid show type %s", showType)
+	}
+
+	output, _ := cmd.Flags().GetString(flagOutput)
+	if !isValidOutput(output) {
+		return fmt.Errorf("invalid output format %s, use one of: %s", output, strings.Join(outputFormats, ", "))
+	}
+	if _, ok := yamlShowTypes[showType]; output == outputYAML && !ok {
+		return fmt.Errorf("the %s output can only be used with the %s, %s, %s and %s show types",
+			outputYAML,
+			chainShowInfo,
+			chainShowAccounts,
+			chainShowPeers,
+			chainShowAll,
+		)
+	}
+	if _, ok := markdownShowTypes[showType]; output == outputMarkdown && !ok {
+		return fmt.Errorf("the %s output can only be used with the %s, %s and %s show types",
+			outputMarkdown,
+			chainShowAccounts,
+			chainShowValidators,
+			chainShowPeers,
+		)
+	}
+	if _, ok := ndjsonShowTypes[showType]; output == outputNDJSON && !ok {
+		return fmt.Errorf("the %s output can only be used with the %s, %s and %s show types",
+			outputNDJSON,
+			chainShowAccounts,
+			chainShowValidators,
+			chainShowPeers,
+		)
+	}
+	if noHeader, _ := cmd.Flags().GetBool(flagNoHeader); noHeader && output == outputMarkdown {
+		return fmt.Errorf("--%s can't be combined with the %s output", flagNoHeader, outputMarkdown)
+	}
+
+	logLevel, _ := cmd.Flags().GetString(flagLogLevel)
+	if maxColumnWidth, _ := cmd.Flags().GetInt(flagMaxColumnWidth); maxColumnWidth < 0 {
+		return fmt.Errorf("--%s can't be negative", flagMaxColumnWidth)
+	}
+
+	logger, err := newQueryLogger(logLevel, cmd.ErrOrStderr())
+	if err != nil {
+		return err
+	}
+
+	var (
+		out, _        = cmd.Flags().GetString(flagOut)
+		validate, _   = cmd.Flags().GetBool(flagValidate)
+		summary, _    = cmd.Flags().GetBool(flagSummary)
+		genesisURL, _ = cmd.Flags().GetString(flagGenesisURL)
+	)
+	if out != "" && showType != chainShowGenesis {
+		return fmt.Errorf("--%s can only be used with the %s show type", flagOut, chainShowGenesis)
+	}
+	if compress, _ := cmd.Flags().GetBool(flagGzip); compress && out == "" {
+		return fmt.Errorf("--%s requires --%s", flagGzip, flagOut)
+	}
+	diff, _ := cmd.Flags().GetBool(flagDiff)
+	if diff {
+		if showType != chainShowGenesis {
+			return fmt.Errorf("--%s can only be used with the %s show type", flagDiff, chainShowGenesis)
+		}
+		if out != "" || summary || genesisURL != "" {
+			return fmt.Errorf("--%s only applies to the local genesis, it can't be combined with --%s, --%s or --%s",
+				flagDiff,
+				flagOut,
+				flagSummary,
+				flagGenesisURL,
+			)
+		}
+	}
+	if validate && showType != chainShowGenesis {
+		return fmt.Errorf("--%s can only be used with the %s show type", flagValidate, chainShowGenesis)
+	}
+	if genesisURL != "" && showType != chainShowGenesis {
+		return fmt.Errorf("--%s can only be used with the %s show type", flagGenesisURL, chainShowGenesis)
+	}
+	fromRPC, _ := cmd.Flags().GetString(flagFromRPC)
+	if fromRPC != "" {
+		if showType != chainShowGenesis {
+			return fmt.Errorf("--%s can only be used with the %s show type", flagFromRPC, chainShowGenesis)
+		}
+		if genesisURL != "" {
+			return fmt.Errorf("--%s can't be combined with --%s", flagFromRPC, flagGenesisURL)
+		}
+	}
+	maxRate, _ := cmd.Flags().GetInt64(flagMaxRate)
+	if maxRate < 0 {
+		return fmt.Errorf("--%s can't be negative", flagMaxRate)
+	}
+	if maxRate > 0 && genesisURL == "" && fromRPC == "" {
+		return fmt.Errorf("--%s can only be used with --%s or --%s", flagMaxRate, flagGenesisURL, flagFromRPC)
+	}
+	if summary {
+		if showType != chainShowGenesis {
+			return fmt.Errorf("--%s can only be used with the %s show type", flagSummary, chainShowGenesis)
+		}
+		if out != "" {
+			return fmt.Errorf("--%s can't be combined with --%s", flagSummary, flagOut)
+		}
+	}
+	if checksum, _ := cmd.Flags().GetBool(flagChecksum); checksum {
+		if showType != chainShowGenesis {
+			return fmt.Errorf("--%s can only be used with the %s show type", flagChecksum, chainShowGenesis)
+		}
+		if out != "" || summary || diff {
+			return fmt.Errorf("--%s can't be combined with --%s, --%s or --%s", flagChecksum, flagOut, flagSummary, flagDiff)
+		}
+	}
+	if pager, _ := cmd.Flags().GetBool(flagPager); pager && showType != chainShowGenesis {
+		return fmt.Errorf("--%s can only be used with the %s show type", flagPager, chainShowGenesis)
+	}
+	if postProcess, _ := cmd.Flags().GetString(flagPostProcess); postProcess != "" {
+		if showType != chainShowGenesis {
+			return fmt.Errorf("--%s can only be used with the %s show type", flagPostProcess, chainShowGenesis)
+		}
+		if checksum, _ := cmd.Flags().GetBool(flagChecksum); out != "" || summary || diff || checksum {
+			return fmt.Errorf("--%s can't be combined with --%s, --%s, --%s or --%s",
+				flagPostProcess,
+				flagOut,
+				flagSummary,
+				flagDiff,
+				flagChecksum,
+			)
+		}
+	}
+
+	peersFormat, _ := cmd.Flags().GetString(flagFormat)
+	if peersFormat != "" {
+		if showType != chainShowPeers {
+			return fmt.Errorf("--%s can only be used with the %s show type", flagFormat, chainShowPeers)
+		}
+		if peersFormat != peersFormatTOML {
+			return fmt.Errorf("invalid peers format %s, only %s is supported", peersFormat, peersFormatTOML)
+		}
+		if output != outputText {
+			return fmt.Errorf("--%s can't be combined with the %s output", flagFormat, output)
+		}
+		// the toml format is the config.toml persistent_peers line
+		peersFormat = peersFormatPersistent
+	}
+
+	if peerFormat, _ := cmd.Flags().GetString(flagPeerFormat); peerFormat != "" {
+		if showType != chainShowPeers {
+			return fmt.Errorf("--%s can only be used with the %s show type", flagPeerFormat, chainShowPeers)
+		}
+		if !isValidPeersFormat(peerFormat) {
+			return fmt.Errorf("invalid peers format %s, use one of: %s", peerFormat, strings.Join(peersFormats, ", "))
+		}
+		if peersFormat != "" {
+			return fmt.Errorf("--%s can't be combined with --%s", flagPeerFormat, flagFormat)
+		}
+		if output != outputText {
+			return fmt.Errorf("--%s can't be combined with the %s output", flagPeerFormat, output)
+		}
+		peersFormat = peerFormat
+	}
+
+	check, _ := cmd.Flags().GetBool(flagCheck)
+	if check {
+		if showType != chainShowPeers {
+			return fmt.Errorf("--%s can only be used with the %s show type", flagCheck, chainShowPeers)
+		}
+		if peersFormat != "" {
+			return fmt.Errorf("--%s can't be combined with a peers format", flagCheck)
+		}
+	}
+
+	addrbook, _ := cmd.Flags().GetString(flagAddrbook)
+	if addrbook != "" {
+		if showType != chainShowPeers {
+			return fmt.Errorf("--%s can only be used with the %s show type", flagAddrbook, chainShowPeers)
+		}
+		if peersFormat != "" || check {
+			return fmt.Errorf("--%s can't be combined with a peers format or --%s", flagAddrbook, flagCheck)
+		}
+	}
+
+	copyPeers, _ := cmd.Flags().GetBool(flagCopy)
+	if copyPeers {
+		if showType != chainShowPeers {
+			return fmt.Errorf("--%s can only be used with the %s show type", flagCopy, chainShowPeers)
+		}
+		if peersFormat != peersFormatPersistent && peersFormat != peersFormatSeeds {
+			return fmt.Errorf("--%s requires the %s or %s peers format", flagCopy, peersFormatPersistent, peersFormatSeeds)
+		}
+	}
+
+	var (
+		mergeConfig, _ = cmd.Flags().GetString(flagMergeConfig)
+		write, _       = cmd.Flags().GetBool(flagWrite)
+	)
+	if mergeConfig != "" {
+		if showType != chainShowPeers {
+			return fmt.Errorf("--%s can only be used with the %s show type", flagMergeConfig, chainShowPeers)
+		}
+		if output != outputText {
+			return fmt.Errorf("--%s can't be combined with the %s output", flagMergeConfig, output)
+		}
+		if peersFormat != "" || check || addrbook != "" {
+			return fmt.Errorf("--%s can't be combined with a peers format, --%s or --%s", flagMergeConfig, flagCheck, flagAddrbook)
+		}
+	}
+	if write && mergeConfig == "" {
+		return fmt.Errorf("--%s requires --%s", flagWrite, flagMergeConfig)
+	}
+
+	verifyKeys, _ := cmd.Flags().GetBool(flagVerifyKeys)
+	if verifyKeys {
+		if showType != chainShowPeers && showType != chainShowValidators {
+			return fmt.Errorf("--%s can only be used with the %s and %s show types", flagVerifyKeys, chainShowPeers, chainShowValidators)
+		}
+		if peersFormat != "" || check || addrbook != "" || mergeConfig != "" {
+			return fmt.Errorf("--%s can't be combined with a peers format, --%s, --%s or --%s",
+				flagVerifyKeys,
+				flagCheck,
+				flagAddrbook,
+				flagMergeConfig,
+			)
+		}
+	}
+
+	power, _ := cmd.Flags().GetBool(flagPower)
+	if power {
+		if showType != chainShowValidators {
+			return fmt.Errorf("--%s can only be used with the %s show type", flagPower, chainShowValidators)
+		}
+		if verifyKeys {
+			return fmt.Errorf("--%s can't be combined with --%s", flagPower, flagVerifyKeys)
+		}
+	}
+
+	peerRPC, _ := cmd.Flags().GetBool(flagPeerRPC)
+	if peerRPC {
+		if showType != chainShowPeers {
+			return fmt.Errorf("--%s can only be used with the %s show type", flagPeerRPC, chainShowPeers)
+		}
+		if peersFormat != "" || check || addrbook != "" || mergeConfig != "" || verifyKeys {
+			return fmt.Errorf("--%s can't be combined with a peers format, --%s, --%s, --%s or --%s",
+				flagPeerRPC,
+				flagCheck,
+				flagAddrbook,
+				flagMergeConfig,
+				flagVerifyKeys,
+			)
+		}
+	}
+
+	if csv, _ := cmd.Flags().GetBool(flagCSV); csv {
+		if showType != chainShowAccounts {
+			return fmt.Errorf("--%s can only be used with the %s show type", flagCSV, chainShowAccounts)
+		}
+		if output != outputText {
+			return fmt.Errorf("--%s can't be combined with the %s output", flagCSV, output)
+		}
+		if totals, _ := cmd.Flags().GetBool(flagTotals); totals {
+			return fmt.Errorf("--%s can't be combined with --%s", flagCSV, flagTotals)
+		}
+	}
+
+	requireNonEmpty, _ := cmd.Flags().GetBool(flagRequireNonEmpty)
+	if requireNonEmpty && showType != chainShowAccounts && showType != chainShowValidators {
+		return fmt.Errorf("--%s can only be used with the %s and %s show types", flagRequireNonEmpty, chainShowAccounts, chainShowValidators)
+	}
+
+	if stream, _ := cmd.Flags().GetBool(flagStream); stream {
+		if csv, _ := cmd.Flags().GetBool(flagCSV); !csv && output != outputNDJSON {
+			return fmt.Errorf("--%s requires --%s or the %s output", flagStream, flagCSV, outputNDJSON)
+		}
+		for _, flag := range []string{flagVesting, flagWide, flagWatch} {
+			if set, _ := cmd.Flags().GetBool(flag); set {
+				return fmt.Errorf("--%s can't be combined with --%s", flagStream, flag)
+			}
+		}
+		if sortBy, _ := cmd.Flags().GetString(flagSortBy); sortBy != "" {
+			return fmt.Errorf("--%s can't be combined with --%s", flagStream, flagSortBy)
+		}
+	}
+
+	if wide, _ := cmd.Flags().GetBool(flagWide); wide {
+		if showType != chainShowAccounts {
+			return fmt.Errorf("--%s can only be used with the %s show type", flagWide, chainShowAccounts)
+		}
+		if output != outputText {
+			return fmt.Errorf("--%s can't be combined with the %s output", flagWide, output)
+		}
+	}
+
+	humanize, _ := cmd.Flags().GetBool(flagHumanize)
+	if humanize {
+		if showType != chainShowAccounts {
+			return fmt.Errorf("--%s can only be used with the %s show type", flagHumanize, chainShowAccounts)
+		}
+		if isStructuredOutput(output) {
+			return fmt.Errorf("--%s can't be combined with the %s output", flagHumanize, output)
+		}
+		for _, flag := range []string{flagCSV, flagStream} {
+			if set, _ := cmd.Flags().GetBool(flag); set {
+				return fmt.Errorf("--%s can't be combined with --%s", flagHumanize, flag)
+			}
+		}
+	}
+	if si, _ := cmd.Flags().GetBool(flagSI); si && !humanize {
+		return fmt.Errorf("--%s requires --%s", flagSI, flagHumanize)
+	}
+	if locale, _ := cmd.Flags().GetString(flagLocale); locale != "" {
+		if !humanize {
+			return fmt.Errorf("--%s requires --%s", flagLocale, flagHumanize)
+		}
+		if _, err := language.Parse(locale); err != nil {
+			return errors.Wrapf(err, "invalid locale %s", locale)
+		}
+	}
+
+	if denoms, _ := cmd.Flags().GetBool(flagDenoms); denoms {
+		if showType != chainShowAccounts {
+			return fmt.Errorf("--%s can only be used with the %s show type", flagDenoms, chainShowAccounts)
+		}
+		for _, flag := range []string{flagTotals, flagCSV, flagWide} {
+			if set, _ := cmd.Flags().GetBool(flag); set {
+				return fmt.Errorf("--%s can't be combined with --%s", flagDenoms, flag)
+			}
+		}
+	}
+
+	if groupBy, _ := cmd.Flags().GetString(flagGroupBy); groupBy != "" {
+		if showType != chainShowAccounts {
+			return fmt.Errorf("--%s can only be used with the %s show type", flagGroupBy, chainShowAccounts)
+		}
+		if groupBy != groupByDenom && groupBy != groupByHolderClass {
+			return fmt.Errorf("invalid --%s %s, use one of: %s, %s", flagGroupBy, groupBy, groupByDenom, groupByHolderClass)
+		}
+		for _, flag := range []string{flagTotals, flagCSV, flagWide, flagDenoms, flagStream, flagCheckDupes, flagHumanize} {
+			if set, _ := cmd.Flags().GetBool(flag); set {
+				return fmt.Errorf("--%s can't be combined with --%s", flagGroupBy, flag)
+			}
+		}
+	}
+
+	// --highlight-mine is an alias of --annotate-mine, the errors name the flag that was set
+	annotateMine, _ := cmd.Flags().GetBool(flagAnnotateMine)
+	mineFlag := flagAnnotateMine
+	if highlightMine, _ := cmd.Flags().GetBool(flagHighlightMine); highlightMine {
+		annotateMine, mineFlag = true, flagHighlightMine
+	}
+	if annotateMine {
+		if showType != chainShowAccounts && showType != chainShowValidators && showType != chainShowAll {
+			return fmt.Errorf("--%s can only be used with the %s, %s and %s show types",
+				mineFlag,
+				chainShowAccounts,
+				chainShowValidators,
+				chainShowAll,
+			)
+		}
+		if stream, _ := cmd.Flags().GetBool(flagStream); stream {
+			return fmt.Errorf("--%s can't be combined with --%s", mineFlag, flagStream)
+		}
+		if columns, _ := cmd.Flags().GetStringSlice(flagColumns); len(columns) > 0 {
+			return fmt.Errorf("--%s can't be combined with --%s, use the %s column instead", mineFlag, flagColumns, accountColumnMine)
+		}
+	}
+
+	if auditVesting, _ := cmd.Flags().GetBool(flagAuditVesting); auditVesting {
+		if showType != chainShowAccounts {
+			return fmt.Errorf("--%s can only be used with the %s show type", flagAuditVesting, chainShowAccounts)
+		}
+		for _, flag := range []string{flagStream, flagCSV, flagDenoms, flagCheckDupes} {
+			if set, _ := cmd.Flags().GetBool(flag); set {
+				return fmt.Errorf("--%s can't be combined with --%s", flagAuditVesting, flag)
+			}
+		}
+	}
+
+	diffAgainst, _ := cmd.Flags().GetUint64(flagDiffAgainst)
+	diffAgainstSet := cmd.Flags().Changed(flagDiffAgainst)
+	if diffAgainstSet {
+		if showType != chainShowAccounts && showType != chainShowValidators {
+			return fmt.Errorf("--%s can only be used with the %s and %s show types",
+				flagDiffAgainst,
+				chainShowAccounts,
+				chainShowValidators,
+			)
+		}
+		for _, flag := range []string{flagStream, flagCSV, flagDenoms, flagCheckDupes, flagAuditVesting, flagVerifyKeys} {
+			if set, _ := cmd.Flags().GetBool(flag); set {
+				return fmt.Errorf("--%s can't be combined with --%s", flagDiffAgainst, flag)
+			}
+		}
+	}
+
+	if checkDupes, _ := cmd.Flags().GetBool(flagCheckDupes); checkDupes {
+		if showType != chainShowAccounts {
+			return fmt.Errorf("--%s can only be used with the %s show type", flagCheckDupes, chainShowAccounts)
+		}
+		for _, flag := range []string{flagStream, flagCSV, flagDenoms} {
+			if set, _ := cmd.Flags().GetBool(flag); set {
+				return fmt.Errorf("--%s can't be combined with --%s", flagCheckDupes, flag)
+			}
+		}
+	}
+
+	columns, _ := cmd.Flags().GetStringSlice(flagColumns)
+	if len(columns) > 0 {
+		if showType != chainShowAccounts {
+			return fmt.Errorf("--%s can only be used with the %s show type", flagColumns, chainShowAccounts)
+		}
+		if isStructuredOutput(output) {
+			return fmt.Errorf("--%s can't be combined with the %s output", flagColumns, output)
+		}
+		for _, flag := range []string{flagWide, flagDenoms} {
+			if set, _ := cmd.Flags().GetBool(flag); set {
+				return fmt.Errorf("--%s can't be combined with --%s", flagColumns, flag)
+			}
+		}
+		if err := validateAccountColumns(columns); err != nil {
+			return err
+		}
+		if stream, _ := cmd.Flags().GetBool(flagStream); stream && hasVestingColumn(columns) {
+			return fmt.Errorf("--%s can't show the vesting columns", flagStream)
+		}
+	}
+
+	if vesting, _ := cmd.Flags().GetBool(flagVesting); vesting && showType != chainShowAccounts {
+		return fmt.Errorf("--%s can only be used with the %s show type", flagVesting, chainShowAccounts)
+	}
+
+	if prefix, _ := cmd.Flags().GetString(flagBech32Prefix); prefix != "" {
+		if showType != chainShowAccounts {
+			return fmt.Errorf("--%s can only be used with the %s show type", flagBech32Prefix, chainShowAccounts)
+		}
+		if err := cosmosutil.ValidateAddressPrefix(prefix); err != nil {
+			return errors.Wrapf(err, "invalid --%s", flagBech32Prefix)
+		}
+	}
+
+	if sortBy, _ := cmd.Flags().GetString(flagSortBy); sortBy != "" {
+		if _, ok := accountsSortColumns[sortBy]; !ok {
+			return fmt.Errorf("invalid sort column %s, use address or coins", sortBy)
+		}
+	}
+
+	if counts, _ := cmd.Flags().GetBool(flagCounts); counts && showType != chainShowInfo {
+		return fmt.Errorf("--%s can only be used with the %s show type", flagCounts, chainShowInfo)
+	}
+	if live, _ := cmd.Flags().GetBool(flagLive); live && showType != chainShowInfo {
+		return fmt.Errorf("--%s can only be used with the %s show type", flagLive, chainShowInfo)
+	}
+	continueOnError, _ := cmd.Flags().GetBool(flagContinueOnError)
+	if continueOnError && showType != chainShowInfo {
+		return fmt.Errorf("--%s can only be used with the %s show type", flagContinueOnError, chainShowInfo)
+	}
+	if concurrency, _ := cmd.Flags().GetInt(flagConcurrency); concurrency < 1 {
+		return fmt.Errorf("--%s must be at least 1", flagConcurrency)
+	}
+
+	fields, _ := cmd.Flags().GetStringSlice(flagFields)
+	if len(fields) > 0 && showType != chainShowInfo {
+		return fmt.Errorf("--%s can only be used with the %s show type", flagFields, chainShowInfo)
+	}
+	if raw, _ := cmd.Flags().GetBool(flagRaw); raw {
+		if len(fields) != 1 {
+			return fmt.Errorf("--%s requires a single field selected with --%s", flagRaw, flagFields)
+		}
+		if output != outputText {
+			return fmt.Errorf("--%s can't be combined with the %s output", flagRaw, output)
+		}
+	}
+
+	explain, _ := cmd.Flags().GetBool(flagExplain)
+	if explain {
+		if showType != chainShowInfo {
+			return fmt.Errorf("--%s can only be used with the %s show type", flagExplain, chainShowInfo)
+		}
+		if output != outputText {
+			return fmt.Errorf("--%s can't be combined with the %s output", flagExplain, output)
+		}
+		if raw, _ := cmd.Flags().GetBool(flagRaw); raw {
+			return fmt.Errorf("--%s can't be combined with --%s", flagExplain, flagRaw)
+		}
+	}
+
+	var infoTemplate *template.Template
+	if text, _ := cmd.Flags().GetString(flagTemplate); text != "" {
+		if showType != chainShowInfo {
+			return fmt.Errorf("--%s can only be used with the %s show type", flagTemplate, chainShowInfo)
+		}
+		if output != outputText {
+			return fmt.Errorf("--%s can't be combined with the %s output", flagTemplate, output)
+		}
+		for _, flag := range []string{flagRaw, flagExplain} {
+			if set, _ := cmd.Flags().GetBool(flag); set {
+				return fmt.Errorf("--%s can't be combined with --%s", flagTemplate, flag)
+			}
+		}
+		if len(fields) > 0 {
+			return fmt.Errorf("--%s can't be combined with --%s", flagTemplate, flagFields)
+		}
+		live, _ := cmd.Flags().GetBool(flagLive)
+		if infoTemplate, err = parseInfoTemplate(text, live); err != nil {
+			return err
+		}
+	}
+
+	if path, _ := cmd.Flags().GetString(flagJSONPath); path != "" {
+		if showType != chainShowInfo {
+			return fmt.Errorf("--%s can only be used with the %s show type", flagJSONPath, chainShowInfo)
+		}
+		if output != outputText {
+			return fmt.Errorf("--%s can't be combined with the %s output", flagJSONPath, output)
+		}
+		for _, flag := range []string{flagRaw, flagExplain} {
+			if set, _ := cmd.Flags().GetBool(flag); set {
+				return fmt.Errorf("--%s can't be combined with --%s", flagJSONPath, flag)
+			}
+		}
+		if len(fields) > 0 {
+			return fmt.Errorf("--%s can't be combined with --%s", flagJSONPath, flagFields)
+		}
+		if infoTemplate != nil {
+			return fmt.Errorf("--%s can't be combined with --%s", flagJSONPath, flagTemplate)
+		}
+		if _, err := jsonpath.Parse(path); err != nil {
+			return err
+		}
+	}
+
+	resolveCoordinator, _ := cmd.Flags().GetBool(flagResolveCoordinator)
+	if resolveCoordinator && showType != chainShowInfo && showType != chainShowAll {
+		return fmt.Errorf("--%s can only be used with the %s and %s show types", flagResolveCoordinator, chainShowInfo, chainShowAll)
+	}
+
+	validator, _ := cmd.Flags().GetString(flagValidator)
+	if validator != "" && showType != chainShowGentxs {
+		return fmt.Errorf("--%s can only be used with the %s show type", flagValidator, chainShowGentxs)
+	}
+	gentxCount, _ := cmd.Flags().GetBool(flagGentxCount)
+	if gentxCount {
+		if showType != chainShowGentxs {
+			return fmt.Errorf("--%s can only be used with the %s show type", flagGentxCount, chainShowGentxs)
+		}
+		if validator != "" {
+			return fmt.Errorf("--%s can't be combined with --%s", flagGentxCount, flagValidator)
+		}
+	}
+
+	requestStatus, _ := cmd.Flags().GetString(flagStatus)
+	if requestStatus != "" {
+		if showType != chainShowRequests {
+			return fmt.Errorf("--%s can only be used with the %s show type", flagStatus, chainShowRequests)
+		}
+		if requestStatus != requestStatusPending {
+			return fmt.Errorf("invalid status %s, SPN only keeps the %s requests", requestStatus, requestStatusPending)
+		}
+	}
+
+	exportDir, _ := cmd.Flags().GetString(flagExportDir)
+	if exportDir != "" {
+		if showType != chainShowAll {
+			return fmt.Errorf("--%s can only be used with the %s show type", flagExportDir, chainShowAll)
+		}
+		if output != outputText {
+			return fmt.Errorf("--%s can't be combined with the %s output", flagExportDir, output)
+		}
+	}
+
+	var addressMatch *regexp.Regexp
+	if pattern, _ := cmd.Flags().GetString(flagAddressMatch); pattern != "" {
+		if showType != chainShowAccounts {
+			return fmt.Errorf("--%s can only be used with the %s show type", flagAddressMatch, chainShowAccounts)
+		}
+		if addressMatch, err = regexp.Compile(pattern); err != nil {
+			return errors.Wrapf(err, "invalid --%s", flagAddressMatch)
+		}
+	}
+
+	var (
+		watch, _             = cmd.Flags().GetBool(flagWatch)
+		pollUntilLaunched, _ = cmd.Flags().GetBool(flagPollUntilLaunched)
+		interval, _          = cmd.Flags().GetDuration(flagInterval)
+	)
+	if _, ok := watchableShowTypes[showType]; watch && !ok {
+		return fmt.Errorf("--%s can only be used with the %s, %s and %s show types",
+			flagWatch,
+			chainShowAccounts,
+			chainShowValidators,
+			chainShowPeers,
+		)
+	}
+	if (watch || pollUntilLaunched) && interval <= 0 {
+		return fmt.Errorf("--%s must be a positive duration", flagInterval)
+	}
+	cacheTTL, _ := cmd.Flags().GetDuration(flagCacheTTL)
+	if cacheTTL < 0 {
+		return fmt.Errorf("--%s can't be negative", flagCacheTTL)
+	}
+	// the watched launches are refreshed from SPN
+	if noCache, _ := cmd.Flags().GetBool(flagNoCache); noCache || watch {
+		cacheTTL = 0
+	}
+
+	if offline, _ := cmd.Flags().GetBool(flagOffline); offline {
+		if showType != chainShowInfo && showType != chainShowGenesis {
+			return fmt.Errorf("--%s can only be used with the %s and %s show types", flagOffline, chainShowInfo, chainShowGenesis)
+		}
+		for _, flag := range []string{flagLive, flagCounts, flagResolveCoordinator, flagDiff, flagByChainID, flagPollUntilLaunched} {
+			if set, _ := cmd.Flags().GetBool(flag); set {
+				return fmt.Errorf("--%s can't be combined with --%s", flagOffline, flag)
+			}
+		}
+		if genesisURL != "" || fromRPC != "" {
+			return fmt.Errorf("--%s can't be combined with --%s or --%s", flagOffline, flagGenesisURL, flagFromRPC)
+		}
+		return networkChainShowOffline(cmd, showType, args[1], output)
+	}
+
+	var nbOptions []NetworkBuilderOption
+	if quiet, _ := cmd.Flags().GetBool(flagQuiet); quiet {
+		nbOptions = append(nbOptions, WithoutSpinner())
+	}
+	nb, err := newNetworkBuilder(cmd, nbOptions...)
+	if err != nil {
+		return err
+	}
+	defer nb.Cleanup()
+
+	// parse launch IDs, a range is only supported for the chain info
+	var launchIDs []uint64
+	byChainID, _ := cmd.Flags().GetBool(flagByChainID)
+	if !byChainID {
+		byChainID = isChainIDArg(args[1])
+	}
+	if !byChainID {
+		launchIDs, err = parseLaunchIDRange(args[1])
+		if err != nil {
+			return err
+		}
+		if len(launchIDs) > 1 && showType != chainShowInfo {
+			return fmt.Errorf("launch ID ranges can only be used with the %s show type", chainShowInfo)
+		}
+		if len(launchIDs) > 1 && explain {
+			return fmt.Errorf("--%s can't be used with a launch ID range", flagExplain)
+		}
+		if len(launchIDs) > 1 && pollUntilLaunched {
+			return fmt.Errorf("--%s can't be used with a launch ID range", flagPollUntilLaunched)
+		}
+	}
+
+	n, err := nb.Network(network.WithLogger(logger))
+	if err != nil {
+		return err
+	}
+
+	var (
+		retries, _ = cmd.Flags().GetUint64(flagRetries)
+		timeout, _ = cmd.Flags().GetDuration(flagTimeout)
+		strict, _  = cmd.Flags().GetBool(flagStrict)
+	)
+
+	fetchCtx, cancel := contextWithTimeout(cmd.Context(), timeout)
+	defer cancel()
+
+	var (
+		launches chainLaunchFetcher        = n
+		genesis  genesisInformationFetcher = retryFetcher{fetcher: n, retries: retries}
+	)
+	if cacheTTL > 0 {
+		dir, err := launchCacheDir(spnNodeAddress)
+		if err != nil {
+			return err
+		}
+		cache := newLaunchCache(dir, cacheTTL, n, genesis)
+		launches, genesis = cache, cache
+	}
+
+	if byChainID {
+		var launchID uint64
+		err := retryQuery(fetchCtx, retries, func() (err error) {
+			launchID, err = n.LaunchIDByChainID(fetchCtx, args[1])
+			return err
+		})
+		if err != nil {
+			return timeoutError(fetchCtx, timeout, err)
+		}
+		launchIDs = []uint64{launchID}
+	}
+
+	// the launches must exist before building any chain from them
+	var (
+		chainLaunches []networktypes.ChainLaunch
+		failures      launchErrors
+	)
+	if continueOnError {
+		chainLaunches, failures = fetchEachChainLaunch(fetchCtx, launches, launchIDs, retries)
+		if len(chainLaunches) == 0 {
+			return failures
+		}
+		// only the launches fetched are shown
+		launchIDs = launchIDs[:0]
+		for _, chainLaunch := range chainLaunches {
+			launchIDs = append(launchIDs, chainLaunch.ID)
+		}
+	} else if chainLaunches, err = fetchChainLaunches(fetchCtx, launches, launchIDs, retries); err != nil {
+		return timeoutError(fetchCtx, timeout, err)
+	}
+	chainLaunch := chainLaunches[0]
+	launchID := launchIDs[0]
+
+	// the base launch must exist to be compared
+	if diffAgainstSet {
+		if diffAgainst == launchID {
+			return fmt.Errorf("--%s must be another launch than %d", flagDiffAgainst, launchID)
+		}
+		if _, err := fetchChainLaunches(fetchCtx, launches, []uint64{diffAgainst}, retries); err != nil {
+			return timeoutError(fetchCtx, timeout, err)
+		}
+	}
+
+	if pollUntilLaunched && !chainLaunch.LaunchTriggered {
+		chainLaunch, err = pollLaunch(cmd.Context(), nb, n, launchID, interval, timeout, retries)
+		if err != nil {
+			return err
+		}
+		chainLaunches[0] = chainLaunch
+	}
+
+	// the launch records are cached to show the chains with --offline later
+	cacheLaunchRecords(getHome(cmd), chainLaunches, logger)
+
+	infoOpts := getInfoOptions(cmd)
+	infoOpts.template = infoTemplate
+	if resolveCoordinator {
+		infoOpts.coordinators = n
+	}
+
+	accountsOpts := getAccountsOptions(cmd)
+	accountsOpts.addressMatch = addressMatch
+	if accountsOpts.humanize {
+		home := getHome(cmd)
+		if home == "" {
+			home = networkchain.ChainHome(launchID)
+		}
+		accountsOpts.displays = genesisDenomDisplays(home)
+	}
+	// the keyring addresses are resolved once for both the accounts and the validators
+	if annotateMine || accountsOpts.hasColumn(accountColumnMine) {
+		if accountsOpts.mine, err = keyringAddresses(nb.AccountRegistry); err != nil {
+			return err
+		}
+	}
+
+	// the remote genesis is fetched by a client sharing the download rate
+	downloadClient := rateLimitedClient(maxRate)
+
+	format := func(ctx context.Context) (string, error) {
+		// the genesis information is fetched at most once for each summary
+		fetcher := genesis
+		if !strict {
+			fetcher = partialGenesisFetcher{fetcher: fetcher, errOut: cmd.ErrOrStderr()}
+		}
+		gi := newGenesisInformationCache(timeoutFetcher{fetcher: fetcher, timeout: timeout})
+
+		// the streamed accounts are counted while they are written
+		if requireNonEmpty && !accountsOpts.stream {
+			if err := checkNonEmpty(ctx, gi, launchID, showType, accountsOpts.vesting); err != nil {
+				return "", err
+			}
+		}
+
+		// the accounts or validators are compared to the base launch instead of being shown
+		if diffAgainstSet {
+			return formatGenesisDiff(ctx, gi, diffAgainst, launchID, showType, output, getTableOptions(cmd))
+		}
+
+		switch showType {
+		case chainShowInfo:
+			summary, err := formatChainsInfo(ctx, nb, gi, chainLaunches, output, infoOpts)
+			return summary, joinLaunchErrors(failures, err)
+		case chainShowGenesis:
+			if diff {
+				c, err := nb.Chain(networkchain.SourceLaunch(chainLaunch))
+				if err != nil {
+					return "", err
+				}
+				return formatChainGenesisDiff(ctx, c, gi, launchID)
+			}
+			if genesisURL != "" {
+				genesisOpts := getGenesisOptions(cmd)
+				if genesisOpts.out != "" {
+					return formatDownloadedGenesis(
+						ctx,
+						downloadClient,
+						genesisURL,
+						spinnerProgress(nb.Spinner, "fetching genesis..."),
+						genesisOpts,
+					)
+				}
+
+				genesis, err := fetchGenesis(
+					ctx,
+					downloadClient,
+					genesisURL,
+					spinnerProgress(nb.Spinner, "fetching genesis..."),
+				)
+				if err != nil {
+					return "", err
+				}
+				if genesis, err = decompressGenesis(genesis); err != nil {
+					return "", err
+				}
+				return formatRemoteGenesis(ctx, genesis, output, genesisOpts)
+			}
+			if fromRPC != "" {
+				genesis, err := fetchRPCGenesis(ctx, downloadClient, fromRPC)
+				if err != nil {
+					return "", err
+				}
+				if genesis, err = decompressGenesis(genesis); err != nil {
+					return "", err
+				}
+				return formatRemoteGenesis(ctx, genesis, output, getGenesisOptions(cmd))
+			}
+			c, err := nb.Chain(networkchain.SourceLaunch(chainLaunch))
+			if err != nil {
+				return "", err
+			}
+			genesisOpts := getGenesisOptions(cmd)
+			genesisOpts.progress = spinnerProgress(nb.Spinner, "reading genesis...")
+			return formatChainGenesis(ctx, c, output, genesisOpts)
+		case chainShowAccounts:
+			if accountsOpts.stream {
+				// the accounts are written as they arrive rather than returned as a summary
+				nb.StopSpinner()
+				return "", spnQuery(ctx, timeout, func(ctx context.Context) error {
+					return streamChainAccounts(ctx, cmd.OutOrStdout(), n, launchID, output, accountsOpts)
+				})
+			}
+			return formatChainAccounts(ctx, gi, launchID, output, accountsOpts)
+		case chainShowValidators:
+			if verifyKeys {
+				return formatPeerKeys(ctx, gi, launchID, output, getTableOptions(cmd))
+			}
+			return formatChainValidators(ctx, gi, launchID, output, validatorsOptions{
+				tableOptions: getTableOptions(cmd),
+				mine:         accountsOpts.mine,
+				power:        power,
+			})
+		case chainShowGentxs:
+			if gentxCount {
+				var count uint64
+				err := spnQuery(ctx, timeout, func(ctx context.Context) error {
+					return retryQuery(ctx, retries, func() (err error) {
+						count, err = n.GenesisValidatorCount(ctx, launchID)
+						return err
+					})
+				})
+				if err != nil {
+					return "", err
+				}
+				return strconv.FormatUint(count, 10), nil
+			}
+			return formatChainGentxs(ctx, gi, launchID, output, validator)
+		case chainShowPeers:
+			var (
+				force, _  = cmd.Flags().GetBool(flagForce)
+				limit, _  = cmd.Flags().GetUint64(flagLimit)
+				offset, _ = cmd.Flags().GetUint64(flagOffset)
+			)
+			if verifyKeys {
+				return formatPeerKeys(ctx, gi, launchID, output, getTableOptions(cmd))
+			}
+			summary, err := formatChainPeers(ctx, gi, launchID, output, peersOptions{
+				tableOptions: getTableOptions(cmd),
+				format:       peersFormat,
+				rpc:          peerRPC,
+				check:        check,
+				addrbook:     addrbook,
+				mergeConfig:  mergeConfig,
+				write:        write,
+				force:        force,
+				limit:        limit,
+				offset:       offset,
+				errOut:       cmd.ErrOrStderr(),
+			})
+			if err == nil && copyPeers {
+				copyToClipboard(ctx, summary, cmd.ErrOrStderr())
+			}
+			return summary, err
+		case chainShowParams:
+			var summary string
+			err := spnQuery(ctx, timeout, func(ctx context.Context) (err error) {
+				summary, err = formatChainParams(ctx, n, output, retries)
+				return err
+			})
+			return summary, err
+		case chainShowRequests:
+			var requests []launchtypes.Request
+			err := spnQuery(ctx, timeout, func(ctx context.Context) error {
+				return retryQuery(ctx, retries, func() (err error) {
+					requests, err = n.Requests(ctx, launchID)
+					return err
+				})
+			})
+			if err != nil {
+				return "", err
+			}
+			return formatChainRequests(requests, output, getTableOptions(cmd))
+		case chainShowAll:
+			if exportDir != "" {
+				force, _ := cmd.Flags().GetBool(flagForce)
+				return exportChainAll(ctx, nb, gi, chainLaunch, exportDir, force, infoOpts, accountsOpts)
+			}
+			return formatChainAll(ctx, nb, gi, chainLaunch, output, infoOpts, accountsOpts)
+		}
+		return "", nil
+	}
+
+	// the size of a remote genesis is confirmed once before any summary, so the answer isn't bounded by the timeout
+	if showType == chainShowGenesis && !diff && !diffAgainstSet {
+		var sizeURL string
+		switch {
+		case genesisURL != "":
+			sizeURL = genesisURL
+		case fromRPC != "":
+			sizeURL = tendermintrpc.New(fromRPC).GenesisURL()
+		}
+		if sizeURL != "" {
+			if err := confirmGenesisDownload(cmd.Context(), nb, downloadClient, sizeURL, getYes(cmd), cmd.ErrOrStderr()); err != nil {
+				return err
+			}
+		}
+	}
+
+	// only the SPN queries of a summary are bounded by the timeout, the genesis
+	// downloads and the post-processing last as long as they need
+	formatSummary := func() (string, error) {
+		summary, err := format(cmd.Context())
+		if output == outputJSON {
+			var wrapErr error
+			if summary, wrapErr = wrapJSON(summary, showType, launchIDs, time.Now()); wrapErr != nil {
+				return "", wrapErr
+			}
+		}
+		return summary, err
+	}
+
+	if !watch {
+		// a summary can be returned along with an error, like the genesis differences
+		summary, err := formatSummary()
+		if summary != "" {
+			nb.StopSpinner()
+			printSummary(cmd, summary)
+		}
+		return err
+	}
+
+	// refresh the summary until the command is canceled
+	return ctxticker.DoNow(cmd.Context(), interval, func() error {
+		summary, err := formatSummary()
+		if err != nil {
+			return err
+		}
+		nb.StopSpinner()
+		fmt.Fprint(cmd.OutOrStdout(), clearScreen)
+		fmt.Fprintln(cmd.OutOrStdout(), summary)
+		return nil
+	})
+}
+
+// networkChainShowOffline shows the info or the genesis of a chain from its home without contacting SPN,
+// the launch record is cached in the home by the previous online runs of the command.
+func networkChainShowOffline(cmd *cobra.Command, showType ShowType, arg, output string) error {
+	// ranges and chain IDs can't be resolved without SPN
+	launchID, err := network.ParseLaunchID(arg)
+	if err != nil {
+		return err
+	}
+	home := getHome(cmd)
+	if home == "" {
+		home = networkchain.ChainHome(launchID)
+	}
+
+	chainLaunch, err := networkchain.LoadLaunchRecord(home)
+	if os.IsNotExist(err) {
+		return fmt.Errorf("launch %d is not cached in %s, run the command once without --%s", launchID, home, flagOffline)
+	}
+	if err != nil {
+		return errors.Wrap(err, "cannot read the cached launch")
+	}
+
+	var summary string
+	switch showType {
+	case chainShowInfo:
+		info := network.OfflineChainInfoSummary(chainLaunch, home)
+		summary, err = formatInfoSummary(cmd.Context(), info, nil, chainLaunch, output, getInfoOptions(cmd))
+	case chainShowGenesis:
+		var genesisPath string
+		if genesisPath, err = resolveGenesisPath(filepath.Join(home, "config", "genesis.json")); err != nil {
+			return err
+		}
+		var genesis []byte
+		if genesis, err = os.ReadFile(genesisPath); err != nil {
+			return err
+		}
+		summary, err = formatRemoteGenesis(cmd.Context(), genesis, output, getGenesisOptions(cmd))
+	}
+	if err != nil {
+		return err
+	}
+	if output == outputJSON {
+		if summary, err = wrapJSON(summary, showType, []uint64{launchID}, time.Now()); err != nil {
+			return err
+		}
+	}
+	printSummary(cmd, summary)
+	return nil
+}
+
+// printSummary writes the summary into the command output, through the pager with --pager.
+func printSummary(cmd *cobra.Command, summary string) {
+	if pager, _ := cmd.Flags().GetBool(flagPager); pager && pageOutput(cmd.OutOrStdout(), cmd.ErrOrStderr(), summary) {
+		return
+	}
+	fmt.Fprintln(cmd.OutOrStdout(), summary)
+}
+
+// defaultPager is the pager used when $PAGER isn't set.
+const defaultPager = "less -R"
+
+// pageOutput writes the text through the pager when out is a terminal, like git does, the stderr
+// of the pager is forwarded to errOut.
+// Nothing is written if the text can't be paged, e.g. when the output is redirected.
+func pageOutput(out, errOut io.Writer, text string) bool {
+	f, ok := out.(*os.File)
+	if !ok || !term.IsTerminal(int(f.Fd())) {
+		return false
+	}
+	args, ok := pagerCommand(os.Getenv, exec.LookPath)
+	if !ok {
+		return false
+	}
+
+	c := exec.Command(args[0], args[1:]...)
+	c.Stdin = strings.NewReader(text + "\n")
+	c.Stdout = f
+	c.Stderr = errOut
+	if err := c.Start(); err != nil {
+		return false
+	}
+	// the exit status of the pager doesn't tell if the text was shown
+	_ = c.Wait()
+	return true
+}
+
+// pagerCommand returns the command of $PAGER, or of the default pager if it isn't set,
+// as long as the pager is available in the path.
+func pagerCommand(getenv func(string) string, lookPath func(string) (string, error)) ([]string, bool) {
+	args := strings.Fields(getenv("PAGER"))
+	if len(args) == 0 {
+		args = strings.Fields(defaultPager)
+	}
+	if _, err := lookPath(args[0]); err != nil {
+		return nil, false
+	}
+	return args, true
+}
+
+// jsonSchemaVersion is the version of the JSON envelope of the show outputs, it is increased
+// with any breaking change of the envelope or of the data of a show type.
+const jsonSchemaVersion = 1
+
+// jsonEnvelope wraps the JSON outputs of the show types.
+type jsonEnvelope struct {
+	SchemaVersion int    `json:"schema_version"`
+	LaunchID      uint64 `json:"launch_id"`
+
+	// LaunchIDs lists the launches when the info of a range of launches is shown.
+	LaunchIDs []uint64 `json:"launch_ids,omitempty"`
+
+	Type      ShowType        `json:"type"`
+	Data      json.RawMessage `json:"data"`
+	FetchedAt time.Time       `json:"fetched_at"`
+}
+
+// wrapJSON wraps the JSON summary of the launches in the envelope. The summaries that aren't JSON,
+// like the status messages of the written files, are returned unchanged.
+func wrapJSON(summary string, showType ShowType, launchIDs []uint64, fetchedAt time.Time) (string, error) {
+	if !json.Valid([]byte(summary)) || len(launchIDs) == 0 {
+		return summary, nil
+	}
+	envelope := jsonEnvelope{
+		SchemaVersion: jsonSchemaVersion,
+		LaunchID:      launchIDs[0],
+		Type:          showType,
+		Data:          json.RawMessage(summary),
+		FetchedAt:     fetchedAt.UTC(),
+	}
+	if len(launchIDs) > 1 {
+		envelope.LaunchIDs = launchIDs
+	}
+	return formatJSON(envelope)
+}
+
+// cacheLaunchRecords saves the launch records in the homes of the chains initialized locally,
+// the home is the default home of each launch if not provided.
+func cacheLaunchRecords(home string, chainLaunches []networktypes.ChainLaunch, logger tmlog.Logger) {
+	for _, chainLaunch := range chainLaunches {
+		chainHome := home
+		if chainHome == "" {
+			chainHome = networkchain.ChainHome(chainLaunch.ID)
+		}
+		if _, err := os.Stat(chainHome); err != nil {
+			continue
+		}
+		if err := networkchain.SaveLaunchRecord(chainHome, chainLaunch); err != nil {
+			logger.Error("cannot cache the launch record", "launchID", chainLaunch.ID, "err", err)
+		}
+	}
+}
+
+// contextWithTimeout returns a context canceled after the timeout, a zero timeout disables it.
+func contextWithTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// timeoutError replaces the error by a timeout error when the context deadline is exceeded.
+func timeoutError(ctx context.Context, timeout time.Duration, err error) error {
+	if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("operation timed out after %s", timeout)
+	}
+	return err
+}
+
+// isChainIDArg checks if the argument is a chain ID rather than a launch ID or range,
+// the launch IDs never contain letters.
+func isChainIDArg(arg string) bool {
+	return strings.IndexFunc(arg, unicode.IsLetter) >= 0
+}
+
+// parseLaunchIDRange parses a launch ID or a range of launch IDs in the start-end format.
+func parseLaunchIDRange(arg string) ([]uint64, error) {
+	bounds := strings.Split(arg, "-")
+	if len(bounds) == 1 {
+		launchID, err := network.ParseLaunchID(arg)
+		if err != nil {
+			return nil, err
+		}
+		return []uint64{launchID}, nil
+	}
+	if len(bounds) != 2 {
+		return nil, fmt.Errorf("invalid launch ID range %s, expected start-end", arg)
+	}
+
+	start, err := network.ParseLaunchID(bounds[0])
+	if err != nil {
+		return nil, err
+	}
+	end, err := network.ParseLaunchID(bounds[1])
+	if err != nil {
+		return nil, err
+	}
+	if start > end {
+		return nil, fmt.Errorf("invalid launch ID range %s, start is greater than end", arg)
+	}
+	if end-start >= maxLaunchIDRange {
+		return nil, fmt.Errorf("invalid launch ID range %s, at most %d launches can be shown", arg, maxLaunchIDRange)
+	}
+
+	launchIDs := make([]uint64, 0, end-start+1)
+	for id := start; id <= end; id++ {
+		launchIDs = append(launchIDs, id)
+	}
+	return launchIDs, nil
+}
+
+// genesisInformationFetcher fetches the genesis information of a launch.
+type genesisInformationFetcher interface {
+	GenesisInformation(ctx context.Context, launchID uint64) (networktypes.GenesisInformation, error)
+}
+
+// genesisInformationCache fetches the genesis information of each launch at most once.
+type genesisInformationCache struct {
+	fetcher genesisInformationFetcher
+	mu      sync.Mutex
+	cache   map[uint64]networktypes.GenesisInformation
+}
+
+func newGenesisInformationCache(fetcher genesisInformationFetcher) *genesisInformationCache {
+	return &genesisInformationCache{
+		fetcher: fetcher,
+		cache:   make(map[uint64]networktypes.GenesisInformation),
+	}
+}
+
+// GenesisInformation returns the cached genesis information of the launch or fetches it.
+func (c *genesisInformationCache) GenesisInformation(
+	ctx context.Context,
+	launchID uint64,
+) (networktypes.GenesisInformation, error) {
+	c.mu.Lock()
+	gi, ok := c.cache[launchID]
+	c.mu.Unlock()
+	if ok {
+		return gi, nil
+	}
+
+	gi, err := c.fetcher.GenesisInformation(ctx, launchID)
+	if err != nil {
+		return gi, err
+	}
+	c.mu.Lock()
+	c.cache[launchID] = gi
+	c.mu.Unlock()
+	return gi, nil
+}
+
+// launchCacheDir returns the directory of the launches cached from the SPN node,
+// in the Starport config directory.
+func launchCacheDir(nodeAddress string) (string, error) {
+	configDir, err := chainconfig.ConfigDirPath()
+	if err != nil {
+		return "", err
+	}
+	node := sha256.Sum256([]byte(nodeAddress))
+	return filepath.Join(configDir, "cache", "launches", fmt.Sprintf("%x", node[:8])), nil
+}
+
+// maxUntriggeredCacheTTL caps the TTL of the launches not triggered yet. SPN keeps no update height
+// or request counter that can be queried for a launch, so the requests settled since a launch was cached
+// can't be detected and the launches still accepting requests are only cached briefly. The genesis
+// information of a triggered launch can't change anymore.
+const maxUntriggeredCacheTTL = 10 * time.Second
+
+// launchCache caches the launches and their genesis information on disk for a TTL so the repeated
+// shows of a launch don't query SPN each time. The TTL is capped to maxUntriggeredCacheTTL for the
+// launches not triggered yet, and the cached genesis information is dropped when the launch queried
+// again differs from the cached launch, like a launch triggered or reverted since.
+type launchCache struct {
+	dir      string
+	ttl      time.Duration
+	launches chainLaunchFetcher
+	genesis  genesisInformationFetcher
+	now      func() time.Time
+}
+
+// launchCacheEntry is a launch cached on disk with its genesis information once fetched.
+type launchCacheEntry struct {
+	Launch             networktypes.ChainLaunch
+	LaunchCachedAt     time.Time
+	GenesisInformation *networktypes.GenesisInformation `json:",omitempty"`
+	GenesisCachedAt    time.Time
+}
+
+func newLaunchCache(
+	dir string,
+	ttl time.Duration,
+	launches chainLaunchFetcher,
+	genesis genesisInformationFetcher,
+) *launchCache {
+	return &launchCache{
+		dir:      dir,
+		ttl:      ttl,
+		launches: launches,
+		genesis:  genesis,
+		now:      time.Now,
+	}
+}
+
+// ChainLaunch returns the cached launch or fetches it.
+func (c *launchCache) ChainLaunch(ctx context.Context, id uint64) (networktypes.ChainLaunch, error) {
+	entry := c.load(id)
+	if c.fresh(entry.LaunchCachedAt, entry.Launch) {
+		return entry.Launch, nil
+	}
+
+	chainLaunch, err := c.launches.ChainLaunch(ctx, id)
+	if err != nil {
+		return chainLaunch, err
+	}
+	if entry.Launch != chainLaunch {
+		entry.GenesisInformation, entry.GenesisCachedAt = nil, time.Time{}
+	}
+	entry.Launch, entry.LaunchCachedAt = chainLaunch, c.now()
+	c.save(id, entry)
+	return chainLaunch, nil
+}
+
+// GenesisInformation returns the cached genesis information of the launch or fetches it,
+// the genesis information fetched with an error isn't cached. The genesis information of
+// a launch that isn't cached as triggered is only kept for maxUntriggeredCacheTTL.
+func (c *launchCache) GenesisInformation(ctx context.Context, launchID uint64) (networktypes.GenesisInformation, error) {
+	entry := c.load(launchID)
+	if entry.GenesisInformation != nil && c.fresh(entry.GenesisCachedAt, entry.Launch) {
+		return *entry.GenesisInformation, nil
+	}
+
+	gi, err := c.genesis.GenesisInformation(ctx, launchID)
+	if err != nil {
+		return gi, err
+	}
+	entry.GenesisInformation, entry.GenesisCachedAt = &gi, c.now()
+	c.save(launchID, entry)
+	return gi, nil
+}
+
+// fresh checks if the value of the launch cached at this time is still valid.
+func (c *launchCache) fresh(cachedAt time.Time, chainLaunch networktypes.ChainLaunch) bool {
+	ttl := c.ttl
+	if !chainLaunch.LaunchTriggered && ttl > maxUntriggeredCacheTTL {
+		ttl = maxUntriggeredCacheTTL
+	}
+	return !cachedAt.IsZero() && c.now().Sub(cachedAt) < ttl
+}
+
+func (c *launchCache) path(launchID uint64) string {
+	return filepath.Join(c.dir, strconv.FormatUint(launchID, 10)+".json")
+}
+
+// load returns the cached entry of the launch, the entry is empty if it isn't cached or can't be read.
+func (c *launchCache) load(launchID uint64) (entry launchCacheEntry) {
+	data, err := os.ReadFile(c.path(launchID))
+	if err != nil {
+		return entry
+	}
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return launchCacheEntry{}
+	}
+	return entry
+}
+
+// save caches the entry of the launch, the entry is written to a temporary file renamed
+// over the previous entry so concurrent reads never see a partial entry.
+// The cache is best effort, the failures are ignored.
+func (c *launchCache) save(launchID uint64, entry launchCacheEntry) {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(c.dir, 0755); err != nil {
+		return
+	}
+	tmp, err := os.CreateTemp(c.dir, "launch-*.tmp")
+	if err != nil {
+		return
+	}
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), c.path(launchID))
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+	}
+}
+
+// warningsOut returns the writer of the warnings, a writer discarding them if w is nil.
+func warningsOut(w io.Writer) io.Writer {
+	if w == nil {
+		return io.Discard
+	}
+	return w
+}
+
+// partialGenesisFetcher returns the sections of the genesis information queried successfully
+// with a warning for each missing section rather than failing.
+type partialGenesisFetcher struct {
+	fetcher genesisInformationFetcher
+
+	// errOut receives the warnings, they are discarded if nil.
+	errOut io.Writer
+}
+
+// GenesisInformation fetches the genesis information of the launch.
+func (f partialGenesisFetcher) GenesisInformation(
+	ctx context.Context,
+	launchID uint64,
+) (networktypes.GenesisInformation, error) {
+	gi, err := f.fetcher.GenesisInformation(ctx, launchID)
+	var sectionErrs network.GenesisInformationError
+	if !errors.As(err, &sectionErrs) {
+		return gi, err
+	}
+	for _, sectionErr := range sectionErrs {
+		fmt.Fprintf(warningsOut(f.errOut), "warning: the %s are missing from the summary: %s\n", sectionErr.Section, sectionErr.Err)
+	}
+	return gi, nil
+}
+
+// chainLaunchFetcher fetches the launch information of a chain.
+type chainLaunchFetcher interface {
+	ChainLaunch(ctx context.Context, id uint64) (networktypes.ChainLaunch, error)
+}
+
+// pollLaunch waits for the launch to be triggered for at most timeout, the spinner
+// shows the wait when it is enabled.
+func pollLaunch(
+	ctx context.Context,
+	nb NetworkBuilder,
+	f chainLaunchFetcher,
+	launchID uint64,
+	interval time.Duration,
+	timeout time.Duration,
+	retries uint64,
+) (networktypes.ChainLaunch, error) {
+	nb.SetSpinnerText(fmt.Sprintf("waiting for the launch %d to be triggered...", launchID))
+
+	pollCtx, cancel := contextWithTimeout(ctx, timeout)
+	defer cancel()
+
+	chainLaunch, err := waitForLaunch(pollCtx, f, launchID, interval, retries)
+	if err != nil && errors.Is(pollCtx.Err(), context.DeadlineExceeded) {
+		return networktypes.ChainLaunch{}, fmt.Errorf("launch %d not triggered after %s", launchID, timeout)
+	}
+	return chainLaunch, err
+}
+
+// waitForLaunch queries the launch every interval until it is triggered or the context is done.
+func waitForLaunch(
+	ctx context.Context,
+	f chainLaunchFetcher,
+	launchID uint64,
+	interval time.Duration,
+	retries uint64,
+) (networktypes.ChainLaunch, error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		var chainLaunch networktypes.ChainLaunch
+		err := retryQuery(ctx, retries, func() (err error) {
+			chainLaunch, err = f.ChainLaunch(ctx, launchID)
+			return err
+		})
+		if err != nil {
+			return networktypes.ChainLaunch{}, err
+		}
+		if chainLaunch.LaunchTriggered {
+			return chainLaunch, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return networktypes.ChainLaunch{}, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// fetchChainLaunches fetches the launches in order and stops at the first launch that doesn't exist
+// with a network.LaunchNotFoundError.
+func fetchChainLaunches(
+	ctx context.Context,
+	f chainLaunchFetcher,
+	launchIDs []uint64,
+	retries uint64,
+) ([]networktypes.ChainLaunch, error) {
+	chainLaunches := make([]networktypes.ChainLaunch, 0, len(launchIDs))
+	for _, id := range launchIDs {
+		var chainLaunch networktypes.ChainLaunch
+		err := retryQuery(ctx, retries, func() (err error) {
+			chainLaunch, err = f.ChainLaunch(ctx, id)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		chainLaunches = append(chainLaunches, chainLaunch)
+	}
+	return chainLaunches, nil
+}
+
+// launchError is the failure of a launch shown with --continue-on-error.
+type launchError struct {
+	launchID uint64
+	err      error
+}
+
+// launchErrors are the failures of the launches of a range shown with --continue-on-error.
+type launchErrors []launchError
+
+// Error implements error.
+func (e launchErrors) Error() string {
+	lines := []string{fmt.Sprintf("%d launches failed:", len(e))}
+	for _, failure := range e {
+		lines = append(lines, fmt.Sprintf("  %d: %s", failure.launchID, failure.err))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// fetchEachChainLaunch fetches the launches independently and returns the launches fetched in order
+// along with the failures of the others.
+func fetchEachChainLaunch(
+	ctx context.Context,
+	f chainLaunchFetcher,
+	launchIDs []uint64,
+	retries uint64,
+) ([]networktypes.ChainLaunch, launchErrors) {
+	var (
+		chainLaunches = make([]networktypes.ChainLaunch, 0, len(launchIDs))
+		failures      launchErrors
+	)
+	for _, id := range launchIDs {
+		launches, err := fetchChainLaunches(ctx, f, []uint64{id}, retries)
+		if err != nil {
+			failures = append(failures, launchError{launchID: id, err: err})
+			continue
+		}
+		chainLaunches = append(chainLaunches, launches...)
+	}
+	return chainLaunches, failures
+}
+
+// joinLaunchErrors adds the launch failures of err to the failures sorted by launch ID,
+// err is returned as is if it isn't made of launch failures.
+func joinLaunchErrors(failures launchErrors, err error) error {
+	var errs launchErrors
+	if err != nil && !errors.As(err, &errs) {
+		return err
+	}
+	failures = append(append(launchErrors{}, failures...), errs...)
+	if len(failures) == 0 {
+		return nil
+	}
+	sort.SliceStable(failures, func(i, j int) bool {
+		return failures[i].launchID < failures[j].launchID
+	})
+	return failures
+}
+
+// retryFetcher retries the genesis information fetches failing with a transient error.
+type retryFetcher struct {
+	fetcher genesisInformationFetcher
+	retries uint64
+}
+
+// GenesisInformation fetches the genesis information of the launch.
+func (f retryFetcher) GenesisInformation(ctx context.Context, launchID uint64) (gi networktypes.GenesisInformation, err error) {
+	err = retryQuery(ctx, f.retries, func() (err error) {
+		gi, err = f.fetcher.GenesisInformation(ctx, launchID)
+		return err
+	})
+	return gi, err
+}
+
+// timeoutFetcher bounds each fetch of the genesis information by the timeout.
+type timeoutFetcher struct {
+	fetcher genesisInformationFetcher
+	timeout time.Duration
+}
+
+// GenesisInformation fetches the genesis information of the launch.
+func (f timeoutFetcher) GenesisInformation(ctx context.Context, launchID uint64) (gi networktypes.GenesisInformation, err error) {
+	err = spnQuery(ctx, f.timeout, func(ctx context.Context) (err error) {
+		gi, err = f.fetcher.GenesisInformation(ctx, launchID)
+		return err
+	})
+	return gi, err
+}
+
+// spnQuery runs the SPN query with a context bounded by the timeout,
+// the query fails with a timeout error once the deadline is exceeded.
+func spnQuery(ctx context.Context, timeout time.Duration, query func(ctx context.Context) error) error {
+	ctx, cancel := contextWithTimeout(ctx, timeout)
+	defer cancel()
+	return timeoutError(ctx, timeout, query(ctx))
+}
+
+// retryQuery runs the SPN query and retries it with an exponential backoff
+// as long as it fails with a transient gRPC error.
+func retryQuery(ctx context.Context, retries uint64, query func() error) error {
+	var attempts int
+	b := backoff.WithContext(backoff.WithMaxRetries(backoff.NewExponentialBackOff(), retries), ctx)
+	err := backoff.Retry(func() error {
+		attempts++
+		err := query()
+		if err != nil && !isTransientError(err) {
+			return backoff.Permanent(err)
+		}
+		return err
+	}, b)
+	if err != nil && attempts > 1 {
+		return errors.Wrapf(err, "query failed after %d attempts", attempts)
+	}
+	return err
+}
+
+// isTransientError checks if the gRPC error is temporary and the query can be retried.
+func isTransientError(err error) bool {
+	switch status.Code(errors.Cause(err)) {
+	case codes.Unavailable, codes.ResourceExhausted, codes.Aborted:
+		return true
+	}
+	return false
+}
+
+// tableOptions configures how the text tables are written.
+type tableOptions struct {
+	noHeader bool
+
+	// maxColumnWidth is the maximum width of the cells, the cells are fit into terminalWidth
+	// if it is 0 and not truncated if both are 0.
+	maxColumnWidth int
+	terminalWidth  int
+}
+
+func getTableOptions(cmd *cobra.Command) tableOptions {
+	var o tableOptions
+	o.noHeader, _ = cmd.Flags().GetBool(flagNoHeader)
+	o.maxColumnWidth, _ = cmd.Flags().GetInt(flagMaxColumnWidth)
+	// the tables are fit into the terminal unless a width is set explicitly
+	if !cmd.Flags().Changed(flagMaxColumnWidth) {
+		o.terminalWidth = terminalWidth(cmd.OutOrStdout())
+	}
+	return o
+}
+
+// terminalWidth returns the width of the terminal out writes into, 0 if out isn't a terminal.
+func terminalWidth(out io.Writer) int {
+	f, ok := out.(*os.File)
+	if !ok {
+		return 0
+	}
+	fd := int(f.Fd())
+	if !term.IsTerminal(fd) {
+		return 0
+	}
+	width, _, err := term.GetSize(fd)
+	if err != nil {
+		return 0
+	}
+	return width
+}
+
+// writeTable writes into out the tabulated entries, the header line is omitted with noHeader.
+// The cells of the text tables are truncated to the maxColumnWidth of table or to fit its terminalWidth.
+func writeTable(out io.Writer, output string, table tableOptions, header []string, entries ...[]string) error {
+	if output == outputMarkdown {
+		return entrywriter.WriteMarkdown(out, header, entries...)
+	}
+	maxWidth := table.maxColumnWidth
+	if maxWidth == 0 {
+		maxWidth = entrywriter.FitColumnWidth(table.terminalWidth, header, entries...)
+	}
+	entries = entrywriter.Truncate(maxWidth, entries...)
+	if table.noHeader {
+		return entrywriter.WriteNoHeader(out, header, entries...)
+	}
+	return entrywriter.Write(out, header, entries...)
+}
+
+// newQueryLogger returns a logger writing to errOut, the stderr of the command, at the given level
+// to keep stdout for the formatted output.
+func newQueryLogger(level string, errOut io.Writer) (tmlog.Logger, error) {
+	for _, l := range logLevels {
+		if level == l {
+			option, err := tmlog.AllowLevel(level)
+			if err != nil {
+				return nil, err
+			}
+			return tmlog.NewFilter(tmlog.NewTMLogger(tmlog.NewSyncWriter(errOut)), option), nil
+		}
+	}
+	return nil, fmt.Errorf("invalid log level %s, use one of: %s", level, strings.Join(logLevels, ", "))
+}
+
+// formatStructured returns the YAML representation of the object with the YAML output,
+// its JSON lines with the NDJSON output and the JSON representation otherwise.
+func formatStructured(ctx context.Context, output string, obj interface{}) (string, error) {
+	switch output {
+	case outputYAML:
+		return yaml.Marshal(ctx, obj)
+	case outputNDJSON:
+		return formatNDJSON(obj)
+	}
+	return formatJSON(obj)
+}
+
+// isStructuredOutput checks if the output format is a structured one rather than a table.
+func isStructuredOutput(output string) bool {
+	return output == outputJSON || output == outputYAML || output == outputNDJSON
+}
+
+// isValidOutput checks if the output format is supported.
+func isValidOutput(output string) bool {
+	for _, format := range outputFormats {
+		if output == format {
+			return true
+		}
+	}
+	return false
+}
+
+// formatJSON returns the indented JSON representation of the object.
+func formatJSON(obj interface{}) (string, error) {
+	out, err := json.MarshalIndent(obj, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// formatNDJSON returns the compact JSON representation of each element of the slice on its own line,
+// an object that isn't a slice is returned as a single line.
+func formatNDJSON(obj interface{}) (string, error) {
+	v := reflect.ValueOf(obj)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		out, err := json.Marshal(obj)
+		return string(out), err
+	}
+
+	lines := make([]string, 0, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		line, err := json.Marshal(v.Index(i).Interface())
+		if err != nil {
+			return "", err
+		}
+		lines = append(lines, string(line))
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// coordinatorResolver resolves the address of a coordinator.
+type coordinatorResolver interface {
+	CoordinatorAddress(ctx context.Context, coordinatorID uint64) (string, error)
+}
+
+// infoOptions configures how the chain info is shown.
+type infoOptions struct {
+	redact bool
+	live   bool
+	counts bool
+	fields []string
+	raw    bool
+
+	// explain appends the description of the fields shown.
+	explain bool
+
+	// template formats the chain info instead of the output format if set.
+	template *template.Template
+
+	// jsonPath selects the values of the JSON chain info to show instead of the output format if set.
+	jsonPath *jsonpath.Path
+
+	// concurrency is the number of chains whose info is fetched at once.
+	concurrency int
+
+	// continueOnError shows the info of the other chains when some fail, the failures are
+	// returned as launchErrors along with the summary.
+	continueOnError bool
+
+	// coordinators resolves the coordinator address, the address isn't shown if nil.
+	coordinators coordinatorResolver
+
+	// errOut receives the warnings, they are discarded if nil.
+	errOut io.Writer
+}
+
+func getInfoOptions(cmd *cobra.Command) infoOptions {
+	var o infoOptions
+	o.errOut = cmd.ErrOrStderr()
+	o.redact, _ = cmd.Flags().GetBool(flagRedact)
+	o.live, _ = cmd.Flags().GetBool(flagLive)
+	o.counts, _ = cmd.Flags().GetBool(flagCounts)
+	o.fields, _ = cmd.Flags().GetStringSlice(flagFields)
+	o.raw, _ = cmd.Flags().GetBool(flagRaw)
+	o.explain, _ = cmd.Flags().GetBool(flagExplain)
+	o.concurrency, _ = cmd.Flags().GetInt(flagConcurrency)
+	o.continueOnError, _ = cmd.Flags().GetBool(flagContinueOnError)
+	// the path is validated with the flags
+	if path, _ := cmd.Flags().GetString(flagJSONPath); path != "" {
+		if parsed, err := jsonpath.Parse(path); err == nil {
+			o.jsonPath = &parsed
+		}
+	}
+	return o
+}
+
+// chainLiveInfo is the launch information of a chain along with its runtime state,
+// the runtime fields are null when no validator RPC is reachable.
+type chainLiveInfo struct {
+	network.ChainInfo `yaml:",inline"`
+	LatestHeight      *int64
+	CatchingUp        *bool
+}
+
+// formatChainsInfo returns the launch information of one or several chains.
+// Several chains are separated by YAML document markers or grouped in a JSON array.
+func formatChainsInfo(
+	ctx context.Context,
+	nb NetworkBuilder,
+	gi genesisInformationFetcher,
+	chainLaunches []networktypes.ChainLaunch,
+	output string,
+	options infoOptions,
+) (string, error) {
+	// the infos are fetched concurrently and stored by index to keep the launches order
+	var (
+		infos = make([]string, len(chainLaunches))
+		errs  = make([]error, len(chainLaunches))
+		sem   = make(chan struct{}, options.concurrency)
+	)
+	g, ctx := errgroup.WithContext(ctx)
+	for i, chainLaunch := range chainLaunches {
+		i, chainLaunch := i, chainLaunch
+		g.Go(func() error {
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+
+			c, err := nb.Chain(networkchain.SourceLaunch(chainLaunch))
+			if err == nil {
+				infos[i], err = formatChainInfo(ctx, c, gi, chainLaunch, output, options)
+			}
+			// the other chains are still shown on failure with continueOnError
+			if options.continueOnError {
+				errs[i] = err
+				return nil
+			}
+			return err
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return "", err
+	}
+
+	var failures launchErrors
+	if options.continueOnError {
+		succeeded := make([]string, 0, len(infos))
+		for i, err := range errs {
+			if err != nil {
+				failures = append(failures, launchError{launchID: chainLaunches[i].ID, err: err})
+				continue
+			}
+			succeeded = append(succeeded, infos[i])
+		}
+		infos = succeeded
+		if len(infos) == 0 {
+			return "", failures
+		}
+	}
+	summary, err := joinChainsInfo(infos, output, options)
+	if err != nil {
+		return "", err
+	}
+	if len(failures) > 0 {
+		return summary, failures
+	}
+	return summary, nil
+}
+
+// joinChainsInfo joins the info of several chains in the output format.
+func joinChainsInfo(infos []string, output string, options infoOptions) (string, error) {
+	if len(infos) == 1 {
+		return infos[0], nil
+	}
+	if options.raw || options.template != nil || options.jsonPath != nil {
+		return strings.Join(infos, "\n"), nil
+	}
+	if output == outputJSON {
+		rawInfos := make([]json.RawMessage, 0, len(infos))
+		for _, info := range infos {
+			rawInfos = append(rawInfos, json.RawMessage(info))
+		}
+		return formatJSON(rawInfos)
+	}
+	return strings.Join(infos, "\n---\n"), nil
+}
+
+// formatChainInfo returns the chain launch information.
+func formatChainInfo(
+	ctx context.Context,
+	c *networkchain.Chain,
+	gi genesisInformationFetcher,
+	chainLaunch networktypes.ChainLaunch,
+	output string,
+	options infoOptions,
+) (string, error) {
+	info, err := network.ChainInfoSummary(ctx, c, chainLaunch)
+	if err != nil {
+		return "", err
+	}
+	if info.HashMatches != nil && !*info.HashMatches {
+		fmt.Fprintln(warningsOut(options.errOut), color.New(color.Bold, color.FgRed).Sprintf(
+			"warning: the source checked out locally doesn't match the source hash %s of launch %d",
+			chainLaunch.SourceHash,
+			chainLaunch.ID,
+		))
+	}
+	if !info.IsInitialized() {
+		fmt.Fprintf(warningsOut(options.errOut), "chain %d is not initialized, run 'starport network chain init %d' first\n",
+			chainLaunch.ID,
+			chainLaunch.ID,
+		)
+	}
+	return formatInfoSummary(ctx, info, gi, chainLaunch, output, options)
+}
+
+// formatInfoSummary returns the chain info completed with the details enabled by the options.
+func formatInfoSummary(
+	ctx context.Context,
+	info network.ChainInfo,
+	gi genesisInformationFetcher,
+	chainLaunch networktypes.ChainLaunch,
+	output string,
+	options infoOptions,
+) (string, error) {
+	if options.counts {
+		genesisInformation, err := gi.GenesisInformation(ctx, chainLaunch.ID)
+		if err != nil {
+			return "", err
+		}
+		info.SetCounts(genesisInformation)
+	}
+
+	// the coordinator ID is shown alone if its address can't be resolved
+	if options.coordinators != nil {
+		address, err := options.coordinators.CoordinatorAddress(ctx, chainLaunch.CoordinatorID)
+		if err == nil {
+			info.CoordinatorAddress = address
+		}
+	}
+
+	if options.redact {
+		info = yaml.Redact(info).(network.ChainInfo)
+	}
+
+	var summary interface{} = info
+	if options.live {
+		liveInfo := chainLiveInfo{ChainInfo: info}
+		if chainLaunch.LaunchTriggered {
+			genesisInformation, err := gi.GenesisInformation(ctx, chainLaunch.ID)
+			if err != nil {
+				return "", err
+			}
+			if syncInfo, ok := chainSyncInfo(ctx, genesisInformation.GenesisValidators); ok {
+				liveInfo.LatestHeight = &syncInfo.LatestBlockHeight
+				liveInfo.CatchingUp = &syncInfo.CatchingUp
+			}
+		}
+		summary = liveInfo
+	}
+
+	if options.template != nil {
+		var out strings.Builder
+		if err := options.template.Execute(&out, summary); err != nil {
+			return "", errors.Wrap(err, "cannot format the chain info with the template")
+		}
+		return out.String(), nil
+	}
+
+	if options.jsonPath != nil {
+		return formatInfoJSONPath(summary, *options.jsonPath)
+	}
+
+	if len(options.fields) > 0 {
+		out, err := formatInfoFields(summary, output, options)
+		if err != nil || !options.explain {
+			return out, err
+		}
+		return out + "\n\n" + explainInfoFields(summary, options.fields), nil
+	}
+
+	switch output {
+	case outputJSON:
+		return formatJSON(summary)
+	case outputYAML:
+		return yaml.Marshal(ctx, summary)
+	}
+	out, err := yaml.MarshalColor(ctx, summary)
+	if err != nil || !options.explain {
+		return out, err
+	}
+	return strings.TrimRight(out, "\n") + "\n\n" + explainInfoFields(summary, nil), nil
+}
+
+// parseInfoTemplate parses the chain info template and checks its field references by executing
+// it against an empty chain info, with the runtime fields if live.
+func parseInfoTemplate(text string, live bool) (*template.Template, error) {
+	tmpl, err := template.New("info").Parse(text)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid template")
+	}
+
+	var summary interface{} = network.ChainInfo{}
+	if live {
+		summary = chainLiveInfo{}
+	}
+	if err := tmpl.Execute(io.Discard, summary); err != nil {
+		return nil, errors.Wrap(err, "invalid template")
+	}
+	return tmpl, nil
+}
+
+// formatInfoJSONPath returns the values of the JSON chain info matched by the path, one per line.
+// The strings are shown unquoted, the objects and arrays as compact JSON.
+func formatInfoJSONPath(summary interface{}, path jsonpath.Path) (string, error) {
+	data, err := json.Marshal(summary)
+	if err != nil {
+		return "", err
+	}
+	// keep the numbers as they are rather than in the float format
+	var doc interface{}
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	if err := d.Decode(&doc); err != nil {
+		return "", err
+	}
+
+	matches := path.Select(doc)
+	if len(matches) == 0 {
+		return "", fmt.Errorf("no chain info value matches %s", path)
+	}
+	lines := make([]string, 0, len(matches))
+	for _, match := range matches {
+		switch value := match.(type) {
+		case nil:
+			lines = append(lines, "null")
+		case string:
+			lines = append(lines, value)
+		case map[string]interface{}, []interface{}:
+			line, err := json.Marshal(value)
+			if err != nil {
+				return "", err
+			}
+			lines = append(lines, string(line))
+		default:
+			lines = append(lines, fmt.Sprint(value))
+		}
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// infoFieldDescriptions describes the chain info fields for --explain.
+var infoFieldDescriptions = map[string]string{
+	"ChainID":            "chain ID of the chain initialized locally",
+	"SourceURL":          "repository the chain binary is built from",
+	"SourceHash":         "git commit the binary is built from",
+	"GenesisURL":         "URL of the custom initial genesis, empty for the default genesis",
+	"GenesisHash":        "hash of the custom initial genesis",
+	"HomePath":           "local home directory of the chain",
+	"CoordinatorID":      "SPN ID of the coordinator of the launch",
+	"CoordinatorAddress": "SPN address of the coordinator",
+	"HashMatches":        "false when the source checked out locally differs from SourceHash",
+	"AccountCount":       "number of genesis accounts, including the vesting accounts",
+	"ValidatorCount":     "number of genesis validators",
+	"LatestHeight":       "latest block height reported by the validators",
+	"CatchingUp":         "whether the node reporting the height is still syncing",
+}
+
+// explainInfoFields returns the description of the chain info fields in their order,
+// only the selected fields are described if any, otherwise the omitted fields are skipped.
+func explainInfoFields(summary interface{}, fields []string) string {
+	selected := make(map[string]struct{})
+	for _, field := range fields {
+		selected[strings.ToLower(strings.TrimSpace(field))] = struct{}{}
+	}
+
+	var shown map[string]json.RawMessage
+	if data, err := json.Marshal(summary); err == nil {
+		_ = json.Unmarshal(data, &shown)
+	}
+
+	var b strings.Builder
+	for _, name := range structFields(reflect.TypeOf(summary)) {
+		if len(selected) > 0 {
+			if _, ok := selected[strings.ToLower(name)]; !ok {
+				continue
+			}
+		} else if _, ok := shown[name]; !ok {
+			continue
+		}
+		if description, ok := infoFieldDescriptions[name]; ok {
+			fmt.Fprintf(&b, "%s: %s\n", name, description)
+		}
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
+
+// formatInfoFields returns the chain info fields in the requested order,
+// the value of the single field is returned alone if raw.
+func formatInfoFields(summary interface{}, output string, options infoOptions) (string, error) {
+	data, err := json.Marshal(summary)
+	if err != nil {
+		return "", err
+	}
+	var values map[string]json.RawMessage
+	if err := json.Unmarshal(data, &values); err != nil {
+		return "", err
+	}
+
+	// the optional fields are omitted from the JSON so the field names come from the struct
+	names := structFieldNames(reflect.TypeOf(summary))
+	selected := make([]string, 0, len(options.fields))
+	for _, field := range options.fields {
+		name, ok := names[strings.ToLower(strings.TrimSpace(field))]
+		if !ok {
+			valid := make([]string, 0, len(names))
+			for _, name := range names {
+				valid = append(valid, name)
+			}
+			sort.Strings(valid)
+			return "", fmt.Errorf("invalid field %s, use one of: %s", field, strings.Join(valid, ", "))
+		}
+		selected = append(selected, name)
+	}
+
+	if options.raw {
+		var value interface{}
+		if raw, ok := values[selected[0]]; ok {
+			// keep the numbers as they are rather than in the float format
+			d := json.NewDecoder(bytes.NewReader(raw))
+			d.UseNumber()
+			if err := d.Decode(&value); err != nil {
+				return "", err
+			}
+		}
+		if value == nil {
+			return "", nil
+		}
+		return fmt.Sprint(value), nil
+	}
+
+	// the selected fields are written as a JSON object to keep their order
+	var doc bytes.Buffer
+	doc.WriteString("{")
+	for i, name := range selected {
+		if i > 0 {
+			doc.WriteString(",")
+		}
+		value, ok := values[name]
+		if !ok {
+			value = json.RawMessage("null")
+		}
+		fmt.Fprintf(&doc, "%q:%s", name, value)
+	}
+	doc.WriteString("}")
+
+	if output == outputJSON {
+		var indented bytes.Buffer
+		if err := json.Indent(&indented, doc.Bytes(), "", "  "); err != nil {
+			return "", err
+		}
+		return indented.String(), nil
+	}
+	out, err := yaml.FromJSON(doc.Bytes())
+	return strings.TrimRight(out, "\n"), err
+}
+
+// structFieldNames returns the names of the fields of the struct type including
+// the fields of the embedded structs, keyed by their lowercase name.
+func structFieldNames(t reflect.Type) map[string]string {
+	names := make(map[string]string)
+	for _, name := range structFields(t) {
+		names[strings.ToLower(name)] = name
+	}
+	return names
+}
+
+// structFields returns the names of the fields of the struct type in their order,
+// the fields of the embedded structs are listed in place.
+func structFields(t reflect.Type) []string {
+	var names []string
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Anonymous {
+			names = append(names, structFields(field.Type)...)
+			continue
+		}
+		names = append(names, field.Name)
+	}
+	return names
+}
+
+// peerRPCAddress returns the address of the default RPC port on the host of the peer.
+func peerRPCAddress(peer string) (string, error) {
+	if err := cosmosutil.ValidatePeer(peer); err != nil {
+		return "", err
+	}
+	host, _, err := net.SplitHostPort(peer[strings.Index(peer, "@")+1:])
+	if err != nil {
+		return "", err
+	}
+	return net.JoinHostPort(host, defaultRPCPort), nil
+}
+
+// chainSyncInfo queries the status of the validator nodes on the default RPC port
+// of their peer host and returns the sync info of the first node to answer.
+// The nodes are queried concurrently within a single liveStatusTimeout.
+func chainSyncInfo(ctx context.Context, validators []networktypes.GenesisValidator) (tendermintrpc.SyncInfo, bool) {
+	ctx, cancel := context.WithTimeout(ctx, liveStatusTimeout)
+	defer cancel()
+
+	// the channel is buffered so the queries still running after the first answer don't block
+	results := make(chan *tendermintrpc.SyncInfo, len(validators))
+	queried := 0
+	for _, val := range validators {
+		rpcAddr, err := peerRPCAddress(val.Peer)
+		if err != nil {
+			continue
+		}
+
+		queried++
+		go func() {
+			syncInfo, err := tendermintrpc.New("http://" + rpcAddr).GetSyncInfo(ctx)
+			if err != nil {
+				results <- nil
+				return
+			}
+			results <- &syncInfo
+		}()
+	}
+
+	for ; queried > 0; queried-- {
+		if syncInfo := <-results; syncInfo != nil {
+			return *syncInfo, true
+		}
+	}
+	return tendermintrpc.SyncInfo{}, false
+}
+
+// formatChainAll returns the info, accounts, peers and validators of the chain in sections.
+// The sections are keyed by name in a single object with the JSON output.
+func formatChainAll(
+	ctx context.Context,
+	nb NetworkBuilder,
+	gi genesisInformationFetcher,
+	chainLaunch networktypes.ChainLaunch,
+	output string,
+	infoOpts infoOptions,
+	accountsOpts accountsOptions,
+) (string, error) {
+	sections := []struct {
+		name   string
+		format func() (string, error)
+	}{
+		{"Info", func() (string, error) {
+			return formatChainsInfo(ctx, nb, gi, []networktypes.ChainLaunch{chainLaunch}, output, infoOpts)
+		}},
+		{"Accounts", func() (string, error) {
+			return formatChainAccounts(ctx, gi, chainLaunch.ID, output, accountsOpts)
+		}},
+		{"Peers", func() (string, error) {
+			return formatChainPeers(ctx, gi, chainLaunch.ID, output, peersOptions{
+				tableOptions: accountsOpts.tableOptions,
+				errOut:       accountsOpts.errOut,
+			})
+		}},
+		{"Validators", func() (string, error) {
+			return formatChainValidators(ctx, gi, chainLaunch.ID, output, validatorsOptions{
+				tableOptions: accountsOpts.tableOptions,
+				mine:         accountsOpts.mine,
+			})
+		}},
+	}
+
+	// the YAML output is converted from the JSON sections
+	sectionsOutput := output
+	if output == outputYAML {
+		sectionsOutput = outputJSON
+	}
+
+	var (
+		texts     = make([]string, 0, len(sections))
+		jsonParts = make(map[string]json.RawMessage)
+	)
+	for _, section := range sections {
+		summary, err := section.format()
+		if err != nil {
+			return "", err
+		}
+		if sectionsOutput == outputJSON {
+			jsonParts[strings.ToLower(section.name)] = json.RawMessage(summary)
+			continue
+		}
+		texts = append(texts, fmt.Sprintf("=== %s ===\n%s", section.name, strings.TrimRight(summary, "\n")))
+	}
+
+	if sectionsOutput == outputJSON {
+		all, err := formatJSON(jsonParts)
+		if err != nil || output == outputJSON {
+			return all, err
+		}
+		return yaml.FromJSON([]byte(all))
+	}
+	return strings.Join(texts, "\n\n"), nil
+}
+
+// exportChainAll writes the info, accounts, peers, validators and genesis of the chain into their own
+// file in the directory, which is created if needed. A non-empty directory is only written with force.
+func exportChainAll(
+	ctx context.Context,
+	nb NetworkBuilder,
+	gi genesisInformationFetcher,
+	chainLaunch networktypes.ChainLaunch,
+	dir string,
+	force bool,
+	infoOpts infoOptions,
+	accountsOpts accountsOptions,
+) (string, error) {
+	if err := prepareExportDir(dir, force); err != nil {
+		return "", err
+	}
+
+	// the accounts are exported in the CSV format regardless of the flags
+	accountsOpts.csv = true
+
+	files := []struct {
+		name   string
+		format func() (string, error)
+	}{
+		{"info.yaml", func() (string, error) {
+			return formatChainsInfo(ctx, nb, gi, []networktypes.ChainLaunch{chainLaunch}, outputYAML, infoOpts)
+		}},
+		{"accounts.csv", func() (string, error) {
+			return formatChainAccounts(ctx, gi, chainLaunch.ID, outputText, accountsOpts)
+		}},
+		{"peers.txt", func() (string, error) {
+			genesisInformation, err := gi.GenesisInformation(ctx, chainLaunch.ID)
+			if err != nil {
+				return "", err
+			}
+			return strings.Join(network.ChainPeersSummary(ctx, genesisInformation).Peers, "\n"), nil
+		}},
+		{"validators.csv", func() (string, error) {
+			validators, err := chainValidators(ctx, gi, chainLaunch.ID)
+			if err != nil {
+				return "", err
+			}
+			var b strings.Builder
+			err = entrywriter.WriteCSV(&b, chainValSummaryHeader, validatorEntries(validators)...)
+			return b.String(), err
+		}},
+	}
+	for _, file := range files {
+		content, err := file.format()
+		if err != nil {
+			return "", errors.Wrapf(err, "cannot export %s", file.name)
+		}
+		if content != "" && !strings.HasSuffix(content, "\n") {
+			content += "\n"
+		}
+		if err := os.WriteFile(filepath.Join(dir, file.name), []byte(content), 0644); err != nil {
+			return "", err
+		}
+	}
+
+	// the genesis is copied from the local chain home
+	c, err := nb.Chain(networkchain.SourceLaunch(chainLaunch))
+	if err != nil {
+		return "", err
+	}
+	genesisPath, err := chainGenesisPath(c)
+	if err != nil {
+		return "", errors.Wrap(err, "cannot export genesis.json")
+	}
+	genesis, err := os.Open(genesisPath)
+	if err != nil {
+		return "", err
+	}
+	defer genesis.Close()
+	if err := writeGenesis(genesis, filepath.Join(dir, "genesis.json"), true, false); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s Launch %d exported: %s", clispinner.OK, chainLaunch.ID, dir), nil
+}
+
+// prepareExportDir creates the export directory if it doesn't exist and
+// checks it is empty unless force is set.
+func prepareExportDir(dir string, force bool) error {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return os.MkdirAll(dir, 0755)
+	}
+	if err != nil {
+		return err
+	}
+	if len(entries) > 0 && !force {
+		return fmt.Errorf("%s is not empty, use --%s to overwrite its files", dir, flagForce)
+	}
+	return nil
+}
+
+// chainGenesisPath returns the resolved path of the chain genesis file and checks it can be read.
+func chainGenesisPath(c *networkchain.Chain) (string, error) {
+	genesisPath, err := c.GenesisPath()
+	if err != nil {
+		return "", err
+	}
+	return resolveGenesisPath(genesisPath)
+}
+
+// resolveGenesisPath returns the absolute path of the genesis with its symlinks followed,
+// so relative and symlinked homes are supported, and checks the genesis can be read.
+func resolveGenesisPath(genesisPath string) (string, error) {
+	absPath, err := filepath.Abs(genesisPath)
+	if err != nil {
+		return "", err
+	}
+
+	resolved, err := filepath.EvalSymlinks(absPath)
+	if err == nil {
+		var f *os.File
+		if f, err = os.Open(resolved); err == nil {
+			f.Close()
+		}
+	}
+	switch {
+	case os.IsNotExist(err):
+		return "", fmt.Errorf("the chain is not initialized, no genesis at %s, run 'starport network chain prepare' first", absPath)
+	case os.IsPermission(err):
+		return "", fmt.Errorf("permission denied to read the genesis at %s", absPath)
+	case err != nil:
+		return "", err
+	}
+	return resolved, nil
+}
+
+// genesisOptions configures how the genesis is shown.
+type genesisOptions struct {
+	out      string
+	force    bool
+	validate bool
+	summary  bool
+	checksum bool
+
+	// gzip compresses the genesis written into out.
+	gzip bool
+
+	// postProcess is a shell command the genesis is piped through, its output is shown instead of the genesis.
+	postProcess string
+
+	// progress is called with the number of bytes read from the genesis if set.
+	progress func(read int64)
+
+	// errOut receives the stderr of the post-process command, it is discarded if nil.
+	errOut io.Writer
+}
+
+func getGenesisOptions(cmd *cobra.Command) genesisOptions {
+	var o genesisOptions
+	o.errOut = cmd.ErrOrStderr()
+	o.out, _ = cmd.Flags().GetString(flagOut)
+	if o.gzip, _ = cmd.Flags().GetBool(flagGzip); o.gzip && !strings.HasSuffix(o.out, gzipExt) {
+		o.out += gzipExt
+	}
+	o.force, _ = cmd.Flags().GetBool(flagForce)
+	o.validate, _ = cmd.Flags().GetBool(flagValidate)
+	o.summary, _ = cmd.Flags().GetBool(flagSummary)
+	o.checksum, _ = cmd.Flags().GetBool(flagChecksum)
+	o.postProcess, _ = cmd.Flags().GetString(flagPostProcess)
+	return o
+}
+
+// formatChainGenesis returns the content of the chain genesis file.
+func formatChainGenesis(ctx context.Context, c *networkchain.Chain, output string, options genesisOptions) (string, error) {
+	genesisPath, err := chainGenesisPath(c)
+	if err != nil {
+		return "", err
+	}
+
+	if options.validate {
+		if err := validateChainGenesis(ctx, c, genesisPath); err != nil {
+			return "", err
+		}
+	}
+
+	f, err := os.Open(genesisPath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	var r io.Reader = f
+	if options.progress != nil {
+		r = &progressReader{r: f, progress: options.progress}
+	}
+
+	if options.out != "" {
+		if err := writeGenesis(r, options.out, options.force, options.gzip); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s Genesis written: %s", clispinner.OK, options.out), nil
+	}
+	if options.summary {
+		return formatGenesisSummary(ctx, r, output)
+	}
+	if options.checksum {
+		return genesisChecksum(r)
+	}
+	if options.postProcess != "" {
+		return postProcessGenesis(ctx, r, options.postProcess, options.errOut)
+	}
+
+	genesis, err := io.ReadAll(r)
+	if err != nil {
+		return "", err
+	}
+	return string(genesis), nil
+}
+
+// formatRemoteGenesis returns the content of a genesis fetched from the network.
+// Only the static validation is performed since the chain binary is not available.
+func formatRemoteGenesis(ctx context.Context, genesis []byte, output string, options genesisOptions) (string, error) {
+	if options.validate {
+		if err := cosmosutil.ValidateGenesis(genesis); err != nil {
+			return "", errors.Wrap(err, "invalid genesis")
+		}
+	}
+	if options.out != "" {
+		if err := writeGenesis(bytes.NewReader(genesis), options.out, options.force, options.gzip); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s Genesis written: %s", clispinner.OK, options.out), nil
+	}
+	if options.summary {
+		return formatGenesisSummary(ctx, bytes.NewReader(genesis), output)
+	}
+	if options.checksum {
+		return genesisChecksum(bytes.NewReader(genesis))
+	}
+	if options.postProcess != "" {
+		return postProcessGenesis(ctx, bytes.NewReader(genesis), options.postProcess, options.errOut)
+	}
+	return string(genesis), nil
+}
+
+// postProcessGenesis pipes the genesis through the shell command and returns its output.
+// The command is arbitrary and runs with the privileges of the user, it is only run when
+// explicitly set with --post-process. The stderr of the command is forwarded to errOut.
+func postProcessGenesis(ctx context.Context, genesis io.Reader, command string, errOut io.Writer) (string, error) {
+	var out bytes.Buffer
+	c := exec.CommandContext(ctx, "sh", "-c", command)
+	c.Stdin = genesis
+	c.Stdout = &out
+	c.Stderr = errOut
+	if err := c.Run(); err != nil {
+		return "", errors.Wrapf(err, "post-process command %q failed", command)
+	}
+	return strings.TrimRight(out.String(), "\n"), nil
+}
+
+// genesisChecksum returns the SHA256 checksum of the genesis in the sha256:<hex> form,
+// the genesis is hashed as it is read.
+func genesisChecksum(genesis io.Reader) (string, error) {
+	h := sha256.New()
+	if _, err := io.Copy(h, genesis); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("sha256:%x", h.Sum(nil)), nil
+}
+
+// fetchGenesis downloads the genesis served at the URL, the download is bounded by maxGenesisSize
+// and canceled after genesisIdleTimeout without data. progress is called with the number of bytes
+// downloaded if set.
+func fetchGenesis(ctx context.Context, client *http.Client, genesisURL string, progress func(read int64)) ([]byte, error) {
+	var genesis bytes.Buffer
+	err := getGenesis(ctx, client, genesisURL, genesisResume{}, progress, func(bool, string) (io.WriteCloser, error) {
+		return nopWriteCloser{&genesis}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return genesis.Bytes(), nil
+}
+
+// fetchRPCGenesis fetches the genesis of the node at the RPC address, the download is canceled
+// after genesisIdleTimeout without data.
+func fetchRPCGenesis(ctx context.Context, client *http.Client, rpcAddress string) ([]byte, error) {
+	idle := newIdleTimeout(ctx, genesisIdleTimeout)
+	defer idle.Stop()
+
+	rpc := tendermintrpc.New(rpcAddress, tendermintrpc.WithHTTPClient(idle.Client(client)))
+	genesis, err := rpc.GetRawGenesis(idle.ctx)
+	if err != nil {
+		return nil, errors.Wrap(idle.Err(err), "cannot fetch the genesis from the RPC")
+	}
+	return genesis, nil
+}
+
+const (
+	// partExt is the extension of the genesis file being downloaded.
+	partExt = ".part"
+
+	// validatorExt is the extension of the file next to the part file holding the ETag or the
+	// Last-Modified date of the genesis being downloaded.
+	validatorExt = ".validator"
+)
+
+// formatDownloadedGenesis downloads the genesis served at the URL into the part file of the out path
+// of the options before streaming it into the out path. The part file is kept when the download fails
+// so the next run with the same out path resumes it.
+func formatDownloadedGenesis(
+	ctx context.Context,
+	client *http.Client,
+	genesisURL string,
+	progress func(read int64),
+	options genesisOptions,
+) (string, error) {
+	part := options.out + partExt
+	if err := downloadGenesis(ctx, client, genesisURL, part, progress); err != nil {
+		return "", err
+	}
+	if err := writeDownloadedGenesis(part, options); err != nil {
+		return "", err
+	}
+	if err := removeGenesisPart(part); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s Genesis written: %s", clispinner.OK, options.out), nil
+}
+
+// writeDownloadedGenesis streams the genesis downloaded into the part path into the out path of
+// the options. The genesis is only held in memory to be validated.
+func writeDownloadedGenesis(part string, options genesisOptions) error {
+	f, err := os.Open(part)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	r, err := decompressGenesisReader(f)
+	if err != nil {
+		return err
+	}
+	if options.validate {
+		genesis, err := io.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		if err := cosmosutil.ValidateGenesis(genesis); err != nil {
+			return errors.Wrap(err, "invalid genesis")
+		}
+		r = bytes.NewReader(genesis)
+	}
+	return writeGenesis(r, options.out, options.force, options.gzip)
+}
+
+// downloadGenesis downloads the genesis served at the URL into the part path, the download resumes
+// from the end of the part file left by an interrupted download when the server supports range
+// requests and the genesis didn't change since, and restarts from scratch otherwise. The download is
+// bounded by maxGenesisSize and canceled after genesisIdleTimeout without data. progress is called
+// with the number of bytes downloaded if set.
+func downloadGenesis(ctx context.Context, client *http.Client, genesisURL, part string, progress func(read int64)) error {
+	resume, err := readGenesisResume(part)
+	if err != nil {
+		return err
+	}
+
+	err = getGenesis(ctx, client, genesisURL, resume, progress, func(resumed bool, validator string) (io.WriteCloser, error) {
+		flags := os.O_CREATE | os.O_WRONLY | os.O_TRUNC
+		if resumed {
+			flags = os.O_CREATE | os.O_WRONLY | os.O_APPEND
+		}
+		// the validator is saved before the data so the part file is never resumed against another genesis
+		if err := writeGenesisValidator(part, validator); err != nil {
+			return nil, err
+		}
+		return os.OpenFile(part, flags, 0644)
+	})
+	var tooLarge genesisTooLargeError
+	switch {
+	case errors.As(err, &tooLarge):
+		removeGenesisPart(part)
+		return err
+	case err != nil:
+		if _, statErr := os.Stat(part); statErr == nil {
+			return errors.Wrapf(err, "run the command again to resume the download from %s", part)
+		}
+		return err
+	}
+	return nil
+}
+
+// genesisResume is the part of the genesis already downloaded by an interrupted download.
+type genesisResume struct {
+	// offset is the number of bytes already downloaded.
+	offset int64
+
+	// validator is the ETag or the Last-Modified date of the genesis already downloaded,
+	// the download restarts from scratch without it.
+	validator string
+}
+
+// readGenesisResume returns the part of the genesis already downloaded into the part path.
+func readGenesisResume(part string) (genesisResume, error) {
+	info, err := os.Stat(part)
+	if os.IsNotExist(err) {
+		return genesisResume{}, nil
+	}
+	if err != nil {
+		return genesisResume{}, err
+	}
+	validator, err := os.ReadFile(part + validatorExt)
+	if os.IsNotExist(err) {
+		return genesisResume{}, nil
+	}
+	if err != nil {
+		return genesisResume{}, err
+	}
+	return genesisResume{offset: info.Size(), validator: string(validator)}, nil
+}
+
+// writeGenesisValidator saves the validator of the genesis downloaded into the part path,
+// a genesis served without validator can't be resumed.
+func writeGenesisValidator(part, validator string) error {
+	if validator == "" {
+		if err := os.Remove(part + validatorExt); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+	return os.WriteFile(part+validatorExt, []byte(validator), 0644)
+}
+
+// removeGenesisPart removes the part file and its validator.
+func removeGenesisPart(part string) error {
+	if err := os.Remove(part + validatorExt); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return os.Remove(part)
+}
+
+// genesisValidator returns the validator of the genesis response to resume its download, only
+// a strong ETag or a Last-Modified date can be sent with If-Range.
+func genesisValidator(resp *http.Response) string {
+	if etag := resp.Header.Get("ETag"); etag != "" && !strings.HasPrefix(etag, "W/") {
+		return etag
+	}
+	return resp.Header.Get("Last-Modified")
+}
+
+// genesisTooLargeError is returned when the genesis is larger than maxGenesisSize.
+type genesisTooLargeError struct{}
+
+// Error implements error.
+func (genesisTooLargeError) Error() string {
+	return fmt.Sprintf("the genesis is larger than %d bytes", maxGenesisSize)
+}
+
+// getGenesis downloads the genesis served at the URL into the writer returned by open, the download
+// resumes after the bytes already downloaded when the server still serves the genesis of their validator.
+// open is called with resumed set when the bytes are appended to the ones already downloaded and
+// with the validator of the genesis served. The download is bounded by maxGenesisSize and canceled
+// after genesisIdleTimeout without data. progress is called with the number of bytes downloaded if set.
+func getGenesis(
+	ctx context.Context,
+	client *http.Client,
+	genesisURL string,
+	resume genesisResume,
+	progress func(read int64),
+	open func(resumed bool, validator string) (io.WriteCloser, error),
+) error {
+	if err := validateGenesisURL(genesisURL); err != nil {
+		return err
+	}
+
+	idle := newIdleTimeout(ctx, genesisIdleTimeout)
+	defer idle.Stop()
+
+	req, err := http.NewRequestWithContext(idle.ctx, http.MethodGet, genesisURL, nil)
+	if err != nil {
+		return err
+	}
+	// the server sends the whole genesis again if it changed since the validator
+	if resume.offset > 0 && resume.validator != "" {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", resume.offset))
+		req.Header.Set("If-Range", resume.validator)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Wrap(idle.Err(err), "cannot fetch the genesis")
+	}
+	defer resp.Body.Close()
+
+	var offset int64
+	switch {
+	case resp.StatusCode == http.StatusPartialContent && resume.validator != "" &&
+		strings.HasPrefix(resp.Header.Get("Content-Range"), fmt.Sprintf("bytes %d-", resume.offset)):
+		offset = resume.offset
+	case resp.StatusCode == http.StatusOK:
+		// the server ignored the range or the genesis changed, the whole genesis is sent again
+	case resp.StatusCode == http.StatusRequestedRangeNotSatisfiable && resume.validator != "":
+		// the part already holds the whole genesis
+		return nil
+	default:
+		return fmt.Errorf("cannot fetch the genesis: %s", resp.Status)
+	}
+
+	w, err := open(offset > 0, genesisValidator(resp))
+	if err != nil {
+		return err
+	}
+	body := idle.Reader(resp.Body)
+	if progress != nil {
+		body = &progressReader{r: body, read: offset, progress: progress}
+	}
+
+	// copy one more byte than the limit to detect the files that are too large
+	n, err := io.Copy(w, io.LimitReader(body, maxGenesisSize-offset+1))
+	if closeErr := w.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return errors.Wrap(idle.Err(err), "cannot fetch the genesis")
+	}
+	if offset+n > maxGenesisSize {
+		return genesisTooLargeError{}
+	}
+	return nil
+}
+
+// nopWriteCloser is a writer with a Close method doing nothing.
+type nopWriteCloser struct {
+	io.Writer
+}
+
+// Close implements io.Closer.
+func (nopWriteCloser) Close() error { return nil }
+
+// idleTimeout cancels a transfer when no data is received for its timeout.
+type idleTimeout struct {
+	ctx     context.Context
+	cancel  context.CancelFunc
+	timer   *time.Timer
+	timeout time.Duration
+	expired int32
+}
+
+// newIdleTimeout returns an idle timeout whose context is canceled once the data
+// read through its readers stops for timeout.
+func newIdleTimeout(ctx context.Context, timeout time.Duration) *idleTimeout {
+	t := &idleTimeout{timeout: timeout}
+	t.ctx, t.cancel = context.WithCancel(ctx)
+	t.timer = time.AfterFunc(timeout, func() {
+		atomic.StoreInt32(&t.expired, 1)
+		t.cancel()
+	})
+	return t
+}
+
+// Reader returns a reader restarting the timeout each time data is read from r.
+func (t *idleTimeout) Reader(r io.Reader) io.Reader {
+	return idleReader{r: r, t: t}
+}
+
+// Client returns a copy of client of which the response bodies restart the timeout as they are read.
+func (t *idleTimeout) Client(client *http.Client) *http.Client {
+	c := *client
+	base := c.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	c.Transport = idleTransport{base: base, t: t}
+	return &c
+}
+
+// idleTransport restarts the idle timeout as the response bodies are read.
+type idleTransport struct {
+	base http.RoundTripper
+	t    *idleTimeout
+}
+
+// RoundTrip implements http.RoundTripper.
+func (tr idleTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := tr.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{tr.t.Reader(resp.Body), resp.Body}
+	return resp, nil
+}
+
+// Err returns an inactivity error instead of err when the transfer was canceled by the timeout.
+func (t *idleTimeout) Err(err error) error {
+	if err != nil && atomic.LoadInt32(&t.expired) == 1 {
+		return fmt.Errorf("no data received for %s", t.timeout)
+	}
+	return err
+}
+
+// Stop stops the timeout and cancels its context.
+func (t *idleTimeout) Stop() {
+	t.timer.Stop()
+	t.cancel()
+}
+
+type idleReader struct {
+	r io.Reader
+	t *idleTimeout
+}
+
+// Read implements io.Reader.
+func (r idleReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	if n > 0 {
+		r.t.timer.Reset(r.t.timeout)
+	}
+	return n, err
+}
+
+// validateGenesisURL checks the genesis URL is an HTTP or HTTPS URL.
+func validateGenesisURL(genesisURL string) error {
+	u, err := url.Parse(genesisURL)
+	if err != nil {
+		return errors.Wrap(err, "invalid genesis URL")
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("invalid genesis URL scheme %q, use http or https", u.Scheme)
+	}
+	return nil
+}
+
+// genesisContentLength returns the size of the genesis served at the URL as advertised by
+// a HEAD request, -1 is returned when the server doesn't advertise it.
+func genesisContentLength(ctx context.Context, client *http.Client, genesisURL string) (int64, error) {
+	if err := validateGenesisURL(genesisURL); err != nil {
+		return 0, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, genesisURL, nil)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, errors.Wrap(err, "cannot fetch the genesis")
+	}
+	resp.Body.Close()
+
+	// some servers don't support HEAD requests, the genesis is then fetched without knowing its size
+	if resp.StatusCode != http.StatusOK {
+		return -1, nil
+	}
+	return resp.ContentLength, nil
+}
+
+// confirmGenesisDownload shows the size of the genesis served at the URL and asks to confirm
+// its download when it is larger than genesisConfirmSize, unless yes is set. The size is written to errOut.
+func confirmGenesisDownload(
+	ctx context.Context,
+	nb NetworkBuilder,
+	client *http.Client,
+	genesisURL string,
+	yes bool,
+	errOut io.Writer,
+) error {
+	size, err := genesisContentLength(ctx, client, genesisURL)
+	if err != nil {
+		return err
+	}
+	if size >= 0 {
+		fmt.Fprintf(errOut, "expected genesis size: %s\n", formatBytes(size))
+	}
+	if size <= genesisConfirmSize || yes {
+		return nil
+	}
+
+	prompt := promptui.Prompt{
+		Label:     fmt.Sprintf("The genesis is %s, would you like to download it", formatBytes(size)),
+		IsConfirm: true,
+	}
+	nb.StopSpinner()
+	if _, err := prompt.Run(); err != nil {
+		return errors.New("genesis download canceled")
+	}
+	nb.StartSpinner()
+	return nil
+}
+
+// rateLimitedClient returns an HTTP client reading the response bodies at most at rate bytes
+// per second, the bodies of successive requests share the rate. The default client is returned for a zero rate.
+func rateLimitedClient(rate int64) *http.Client {
+	if rate <= 0 {
+		return http.DefaultClient
+	}
+	return &http.Client{
+		Transport: rateLimitedTransport{
+			base:    http.DefaultTransport,
+			limiter: ratelimit.New(rate),
+		},
+	}
+}
+
+// rateLimitedTransport limits the rate at which the response bodies are read.
+type rateLimitedTransport struct {
+	base    http.RoundTripper
+	limiter *ratelimit.Limiter
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t rateLimitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{t.limiter.Reader(req.Context(), resp.Body), resp.Body}
+	return resp, nil
+}
+
+// progressReader reports the number of bytes read so far after each read.
+type progressReader struct {
+	r        io.Reader
+	read     int64
+	progress func(read int64)
+}
+
+// Read implements io.Reader.
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	if n > 0 {
+		r.read += int64(n)
+		r.progress(r.read)
+	}
+	return n, err
+}
+
+// spinnerProgress returns a progress func showing the number of bytes read on the spinner,
+// the progress isn't reported without a spinner.
+func spinnerProgress(s *clispinner.Spinner, text string) func(read int64) {
+	if s == nil {
+		return nil
+	}
+	return func(read int64) {
+		s.SetText(fmt.Sprintf("%s %s", text, formatBytes(read))).Start()
+	}
+}
+
+// formatBytes returns the byte count in the largest unit keeping it above 1.
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.0f %cB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+// formatGenesisSummary returns the main fields of the genesis along with
+// its module list and account and validator counts.
+func formatGenesisSummary(ctx context.Context, r io.Reader, output string) (string, error) {
+	var genesis struct {
+		ChainID       string                     `json:"chain_id"`
+		GenesisTime   string                     `json:"genesis_time"`
+		InitialHeight json.Number                `json:"initial_height"`
+		AppState      map[string]json.RawMessage `json:"app_state"`
+	}
+	if err := json.NewDecoder(r).Decode(&genesis); err != nil {
+		return "", errors.Wrap(err, "cannot parse the genesis")
+	}
+
+	var (
+		auth struct {
+			Accounts []json.RawMessage `json:"accounts"`
+		}
+		staking struct {
+			Validators []json.RawMessage `json:"validators"`
+		}
+		genutil struct {
+			GenTxs []json.RawMessage `json:"gen_txs"`
+		}
+		modules = make([]string, 0, len(genesis.AppState))
+	)
+	for module := range genesis.AppState {
+		modules = append(modules, module)
+	}
+	sort.Strings(modules)
+
+	for module, state := range map[string]interface{}{
+		"auth":    &auth,
+		"staking": &staking,
+		"genutil": &genutil,
+	} {
+		if raw, ok := genesis.AppState[module]; ok {
+			if err := json.Unmarshal(raw, state); err != nil {
+				return "", errors.Wrapf(err, "cannot parse the %s genesis", module)
+			}
+		}
+	}
+
+	// validators are either in the staking state or still in the gentxs before the chain is started
+	summary := struct {
+		ChainID       string   `json:"chain_id" yaml:"chain_id"`
+		GenesisTime   string   `json:"genesis_time" yaml:"genesis_time"`
+		InitialHeight string   `json:"initial_height" yaml:"initial_height"`
+		Modules       []string `json:"modules" yaml:"modules"`
+		Accounts      int      `json:"accounts" yaml:"accounts"`
+		Validators    int      `json:"validators" yaml:"validators"`
+	}{
+		ChainID:       genesis.ChainID,
+		GenesisTime:   genesis.GenesisTime,
+		InitialHeight: genesis.InitialHeight.String(),
+		Modules:       modules,
+		Accounts:      len(auth.Accounts),
+		Validators:    len(staking.Validators) + len(genutil.GenTxs),
+	}
+	if output == outputJSON {
+		return formatJSON(summary)
+	}
+	return yaml.Marshal(ctx, summary)
+}
+
+// formatChainGenesisDiff returns the unified diff between the genesis expected from the launch
+// information and the local chain genesis. The accounts with their balance and the validators
+// with their self-delegation are compared, errGenesisDiff is returned if they differ.
+func formatChainGenesisDiff(
+	ctx context.Context,
+	c *networkchain.Chain,
+	gi genesisInformationFetcher,
+	launchID uint64,
+) (string, error) {
+	genesisPath, err := chainGenesisPath(c)
+	if err != nil {
+		return "", err
+	}
+	genesis, err := os.ReadFile(genesisPath)
+	if err != nil {
+		return "", err
+	}
+	actual, err := genesisComparable(genesis)
+	if err != nil {
+		return "", err
+	}
+
+	genesisInformation, err := gi.GenesisInformation(ctx, launchID)
+	if err != nil {
+		return "", err
+	}
+	expected, err := launchComparable(genesisInformation, actual.addressPrefix())
+	if err != nil {
+		return "", err
+	}
+
+	expectedJSON, err := formatJSON(expected)
+	if err != nil {
+		return "", err
+	}
+	actualJSON, err := formatJSON(actual)
+	if err != nil {
+		return "", err
+	}
+
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(expectedJSON + "\n"),
+		B:        difflib.SplitLines(actualJSON + "\n"),
+		FromFile: fmt.Sprintf("launch %d", launchID),
+		ToFile:   genesisPath,
+		Context:  3,
+	})
+	if err != nil {
+		return "", err
+	}
+	if diff == "" {
+		return fmt.Sprintf("%s The genesis matches the launch information", clispinner.OK), nil
+	}
+	return strings.TrimRight(diff, "\n"), errGenesisDiff
+}
+
+// errGenesisDiff is returned when the genesis differs from the launch information.
+var errGenesisDiff = errors.New("the genesis differs from the launch information")
+
+type (
+	// comparableGenesis holds the parts of a genesis built from the launch information.
+	comparableGenesis struct {
+		Accounts   []comparableAccount   `json:"accounts"`
+		Validators []comparableValidator `json:"validators"`
+	}
+
+	comparableAccount struct {
+		Address string `json:"address"`
+		Coins   string `json:"coins"`
+	}
+
+	comparableValidator struct {
+		DelegatorAddress string `json:"delegatorAddress"`
+		SelfDelegation   string `json:"selfDelegation"`
+	}
+)
+
+// addressPrefix returns the address prefix used in the genesis or an empty string if unknown.
+func (g comparableGenesis) addressPrefix() string {
+	addresses := make([]string, 0, len(g.Accounts)+len(g.Validators))
+	for _, acc := range g.Accounts {
+		addresses = append(addresses, acc.Address)
+	}
+	for _, val := range g.Validators {
+		addresses = append(addresses, val.DelegatorAddress)
+	}
+	for _, address := range addresses {
+		if prefix, err := cosmosutil.GetAddressPrefix(address); err == nil {
+			return prefix
+		}
+	}
+	return ""
+}
+
+// sort sorts the accounts and validators by address to compare them regardless of their order.
+func (g comparableGenesis) sort() {
+	sort.Slice(g.Accounts, func(i, j int) bool { return g.Accounts[i].Address < g.Accounts[j].Address })
+	sort.Slice(g.Validators, func(i, j int) bool {
+		return g.Validators[i].DelegatorAddress < g.Validators[j].DelegatorAddress
+	})
+}
+
+// genesisComparable extracts the balances and gentxs of the genesis.
+func genesisComparable(genesis []byte) (comparableGenesis, error) {
+	var doc struct {
+		AppState struct {
+			Bank struct {
+				Balances []struct {
+					Address string    `json:"address"`
+					Coins   sdk.Coins `json:"coins"`
+				} `json:"balances"`
+			} `json:"bank"`
+			Genutil struct {
+				GenTxs []json.RawMessage `json:"gen_txs"`
+			} `json:"genutil"`
+		} `json:"app_state"`
+	}
+	if err := json.Unmarshal(genesis, &doc); err != nil {
+		return comparableGenesis{}, errors.Wrap(err, "cannot parse the genesis")
+	}
+
+	g := comparableGenesis{
+		Accounts:   make([]comparableAccount, 0),
+		Validators: make([]comparableValidator, 0),
+	}
+	for _, balance := range doc.AppState.Bank.Balances {
+		g.Accounts = append(g.Accounts, comparableAccount{
+			Address: balance.Address,
+			Coins:   balance.Coins.String(),
+		})
+	}
+	for i, gentx := range doc.AppState.Genutil.GenTxs {
+		info, _, err := cosmosutil.ParseGentx(gentx)
+		if err != nil {
+			return comparableGenesis{}, errors.Wrapf(err, "cannot parse the gentx %d of the genesis", i)
+		}
+		g.Validators = append(g.Validators, comparableValidator{
+			DelegatorAddress: info.DelegatorAddress,
+			SelfDelegation:   info.SelfDelegation.String(),
+		})
+	}
+	g.sort()
+	return g, nil
+}
+
+// launchComparable returns the balances and gentxs the genesis must contain from the launch information.
+// The account addresses are converted to the address prefix if set.
+func launchComparable(gi networktypes.GenesisInformation, addressPrefix string) (comparableGenesis, error) {
+	g := comparableGenesis{
+		Accounts:   make([]comparableAccount, 0),
+		Validators: make([]comparableValidator, 0),
+	}
+
+	addAccount := func(address, coins string) error {
+		var err error
+		if addressPrefix != "" {
+			if address, err = cosmosutil.ChangeAddressPrefix(address, addressPrefix); err != nil {
+				return err
+			}
+		}
+		parsedCoins, err := sdk.ParseCoinsNormalized(coins)
+		if err != nil {
+			return errors.Wrapf(err, "invalid coins for account %s", address)
+		}
+		g.Accounts = append(g.Accounts, comparableAccount{
+			Address: address,
+			Coins:   parsedCoins.String(),
+		})
+		return nil
+	}
+	for _, acc := range gi.GenesisAccounts {
+		if err := addAccount(acc.Address, acc.Coins); err != nil {
+			return comparableGenesis{}, err
+		}
+	}
+	for _, acc := range gi.VestingAccounts {
+		if err := addAccount(acc.Address, acc.TotalBalance); err != nil {
+			return comparableGenesis{}, err
+		}
+	}
+
+	for _, val := range gi.GenesisValidators {
+		info, _, err := cosmosutil.ParseGentx(val.Gentx)
+		if err != nil {
+			return comparableGenesis{}, errors.Wrapf(err, "cannot parse the gentx of %s", val.Address)
+		}
+		g.Validators = append(g.Validators, comparableValidator{
+			DelegatorAddress: info.DelegatorAddress,
+			SelfDelegation:   info.SelfDelegation.String(),
+		})
+	}
+	g.sort()
+	return g, nil
+}
+
+// validateChainGenesis checks the chain genesis file is valid JSON with valid fields
+// and runs the genesis validation of the chain modules.
+func validateChainGenesis(ctx context.Context, c *networkchain.Chain, genesisPath string) error {
+	genesis, err := os.ReadFile(genesisPath)
+	if err != nil {
+		return err
+	}
+	if err := cosmosutil.ValidateGenesis(genesis); err != nil {
+		return errors.Wrap(err, "invalid genesis")
+	}
+	return errors.Wrap(c.ValidateGenesis(ctx), "invalid genesis")
+}
+
+// writeGenesis streams the genesis into the out path, the genesis is compressed as it is written with compress.
+// The genesis is written to a temporary file next to out renamed over it once complete, so a failed
+// write never leaves a partial genesis nor truncates the previous one.
+func writeGenesis(src io.Reader, out string, force, compress bool) error {
+	if !force {
+		_, err := os.Stat(out)
+		if err == nil {
+			return fmt.Errorf("%s already exists, use --%s to overwrite it", out, flagForce)
+		}
+		if !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	dst, err := os.CreateTemp(filepath.Dir(out), filepath.Base(out)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	if err := writeGenesisFile(dst, src, compress); err != nil {
+		os.Remove(dst.Name())
+		return err
+	}
+	if err := os.Rename(dst.Name(), out); err != nil {
+		os.Remove(dst.Name())
+		return err
+	}
+	return nil
+}
+
+// writeGenesisFile streams the genesis into dst and closes it.
+func writeGenesisFile(dst *os.File, src io.Reader, compress bool) error {
+	// the temporary files are only readable by their owner
+	if err := dst.Chmod(0644); err != nil {
+		dst.Close()
+		return err
+	}
+	var w io.WriteCloser = dst
+	if compress {
+		w = gzip.NewWriter(dst)
+	}
+	if _, err := io.Copy(w, src); err != nil {
+		dst.Close()
+		return err
+	}
+	// the gzip writer must be closed to flush the compressed data before the file
+	if compress {
+		if err := w.Close(); err != nil {
+			dst.Close()
+			return err
+		}
+	}
+	return dst.Close()
+}
+
+// gzipExt is the extension of the compressed genesis files.
+const gzipExt = ".gz"
+
+// gzipMagic are the first bytes of any gzip stream.
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// decompressGenesisReader returns a reader of the genesis decompressed if it is gzipped and
+// as is otherwise, the decompressed genesis is bounded by maxGenesisSize.
+func decompressGenesisReader(genesis io.Reader) (io.Reader, error) {
+	br := bufio.NewReader(genesis)
+	magic, err := br.Peek(len(gzipMagic))
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	if !bytes.Equal(magic, gzipMagic) {
+		return br, nil
+	}
+	r, err := gzip.NewReader(br)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot decompress the genesis")
+	}
+	return &maxSizeReader{r: r, max: maxGenesisSize}, nil
+}
+
+// maxSizeReader fails once more than max bytes are read.
+type maxSizeReader struct {
+	r    io.Reader
+	max  int64
+	read int64
+}
+
+// Read implements io.Reader.
+func (r *maxSizeReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	if r.read += int64(n); r.read > r.max {
+		return n, fmt.Errorf("the decompressed genesis is larger than %d bytes", r.max)
+	}
+	return n, err
+}
+
+// decompressGenesis returns the genesis decompressed if it is gzipped and as is otherwise,
+// the decompressed genesis is bounded by maxGenesisSize.
+func decompressGenesis(genesis []byte) ([]byte, error) {
+	if !bytes.HasPrefix(genesis, gzipMagic) {
+		return genesis, nil
+	}
+	r, err := gzip.NewReader(bytes.NewReader(genesis))
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot decompress the genesis")
+	}
+	defer r.Close()
+
+	decompressed, err := io.ReadAll(io.LimitReader(r, maxGenesisSize+1))
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot decompress the genesis")
+	}
+	if len(decompressed) > maxGenesisSize {
+		return nil, fmt.Errorf("the decompressed genesis is larger than %d bytes", maxGenesisSize)
+	}
+	return decompressed, nil
+}
+
+// accountsOptions configures how the genesis accounts are shown.
+type accountsOptions struct {
+	tableOptions
+
+	denom   string
+	limit   uint64
+	offset  uint64
+	totals  bool
+	csv     bool
+	wide    bool
+	denoms  bool
+	vesting bool
+	sortBy  string
+
+	// stream writes the accounts in the CSV format as they are fetched.
+	stream bool
+
+	// requireNonEmpty fails the streamed accounts when the launch has none.
+	requireNonEmpty bool
+
+	// checkDupes shows the accounts sharing their address instead of the accounts.
+	checkDupes bool
+
+	// auditVesting shows the vesting accounts whose vesting coins don't match their balance instead of the accounts.
+	auditVesting bool
+
+	// groupBy shows the accounts grouped by denom or by holder class instead of the accounts.
+	groupBy string
+
+	// columns are the names of the columns of the accounts table, the default columns are used if empty.
+	columns []string
+
+	// addressMatch keeps only the accounts whose address matches if set.
+	addressMatch *regexp.Regexp
+
+	// bech32Prefix re-encodes the account addresses with this prefix if set.
+	bech32Prefix string
+
+	// mine holds the SPN addresses of the local keys to highlight, nothing is highlighted if nil.
+	mine map[string]struct{}
+
+	// humanize formats the amounts with the thousands and decimal separators of separators,
+	// or SI suffixes if si, in the display denoms of displays.
+	humanize   bool
+	si         bool
+	separators numberSeparators
+	displays   map[string]denomDisplay
+
+	// errOut receives the warnings, they are discarded if nil.
+	errOut io.Writer
+}
+
+func getAccountsOptions(cmd *cobra.Command) accountsOptions {
+	var o accountsOptions
+	o.errOut = cmd.ErrOrStderr()
+	o.denom, _ = cmd.Flags().GetString(flagDenom)
+	o.limit, _ = cmd.Flags().GetUint64(flagLimit)
+	o.offset, _ = cmd.Flags().GetUint64(flagOffset)
+	o.totals, _ = cmd.Flags().GetBool(flagTotals)
+	o.csv, _ = cmd.Flags().GetBool(flagCSV)
+	o.wide, _ = cmd.Flags().GetBool(flagWide)
+	o.denoms, _ = cmd.Flags().GetBool(flagDenoms)
+	o.tableOptions = getTableOptions(cmd)
+	o.vesting, _ = cmd.Flags().GetBool(flagVesting)
+	o.sortBy, _ = cmd.Flags().GetString(flagSortBy)
+	o.bech32Prefix, _ = cmd.Flags().GetString(flagBech32Prefix)
+	o.stream, _ = cmd.Flags().GetBool(flagStream)
+	o.checkDupes, _ = cmd.Flags().GetBool(flagCheckDupes)
+	o.auditVesting, _ = cmd.Flags().GetBool(flagAuditVesting)
+	o.groupBy, _ = cmd.Flags().GetString(flagGroupBy)
+	columns, _ := cmd.Flags().GetStringSlice(flagColumns)
+	for _, column := range columns {
+		o.columns = append(o.columns, normalizeColumn(column))
+	}
+	// the vesting columns show the vesting accounts
+	if hasVestingColumn(o.columns) {
+		o.vesting = true
+	}
+	o.requireNonEmpty, _ = cmd.Flags().GetBool(flagRequireNonEmpty)
+	o.humanize, _ = cmd.Flags().GetBool(flagHumanize)
+	o.si, _ = cmd.Flags().GetBool(flagSI)
+	o.separators = defaultSeparators
+	// the locale is validated with the flags
+	if locale, _ := cmd.Flags().GetString(flagLocale); locale != "" {
+		o.separators = localeSeparators(language.Make(locale))
+	}
+	return o
+}
+
+// filter returns the accounts matching the options and the total count of matching accounts before pagination.
+func (o accountsOptions) filter(accounts []networktypes.GenesisAccount) ([]networktypes.GenesisAccount, int, error) {
+	filtered := make([]networktypes.GenesisAccount, 0)
+	for _, acc := range accounts {
+		ok, err := o.matches(acc)
+		if err != nil {
+			return nil, 0, err
+		}
+		if ok {
+			filtered = append(filtered, acc)
+		}
+	}
+
+	if col, ok := accountsSortColumns[o.sortBy]; ok {
+		sort.SliceStable(filtered, func(i, j int) bool {
+			return entrywriter.Less(accountEntry(filtered[i])[col], accountEntry(filtered[j])[col])
+		})
+	}
+
+	total := len(filtered)
+	if o.offset >= uint64(total) {
+		return []networktypes.GenesisAccount{}, total, nil
+	}
+	filtered = filtered[o.offset:]
+	if o.limit > 0 && o.limit < uint64(len(filtered)) {
+		filtered = filtered[:o.limit]
+	}
+	return filtered, total, nil
+}
+
+// hasColumn checks if the column is one of the selected columns.
+func (o accountsOptions) hasColumn(name string) bool {
+	for _, column := range o.columns {
+		if column == name {
+			return true
+		}
+	}
+	return false
+}
+
+// header returns the header of the accounts table, the selected columns or the default ones.
+func (o accountsOptions) header() []string {
+	if len(o.columns) == 0 {
+		header := chainAccSummaryHeader
+		if o.vesting {
+			header = append(append([]string{}, header...), chainAccVestingHeader...)
+		}
+		if o.mine != nil {
+			header = append(append([]string{}, header...), chainAccMineHeader)
+		}
+		return header
+	}
+
+	header := make([]string, 0, len(o.columns))
+	for _, name := range o.columns {
+		header = append(header, accountColumns[name].header)
+	}
+	return header
+}
+
+// entry returns the row of the account in the accounts table.
+func (o accountsOptions) entry(acc networktypes.GenesisAccount, vestingAccounts map[string]networktypes.VestingAccount) []string {
+	if len(o.columns) == 0 {
+		entry := accountEntry(acc)
+		if o.humanize {
+			entry[1] = humanizeCoins(acc.Coins, o.displays, o.si, o.separators)
+		}
+		if o.vesting {
+			vestingType, vestingEnd := accountVesting(vestingAccounts, acc.Address)
+			entry = append(entry, vestingType, vestingEnd)
+		}
+		if o.mine != nil {
+			entry = append(entry, strconv.FormatBool(isMine(o.mine, acc.Address)))
+		}
+		return entry
+	}
+
+	entry := make([]string, 0, len(o.columns))
+	for _, name := range o.columns {
+		if name == accountColumnCoins && o.humanize {
+			entry = append(entry, humanizeCoins(acc.Coins, o.displays, o.si, o.separators))
+			continue
+		}
+		entry = append(entry, accountColumns[name].cell(acc, vestingAccounts, o.mine))
+	}
+	return entry
+}
+
+// matches checks if the account address matches the address pattern of the options if any
+// and if the account holds a balance in the denom of the options if any.
+func (o accountsOptions) matches(acc networktypes.GenesisAccount) (bool, error) {
+	if o.addressMatch != nil && !o.addressMatch.MatchString(acc.Address) {
+		return false, nil
+	}
+	if o.denom == "" {
+		return true, nil
+	}
+	coins, err := sdk.ParseCoinsNormalized(acc.Coins)
+	if err != nil {
+		return false, errors.Wrapf(err, "invalid coins for account %s", acc.Address)
+	}
+	return coins.AmountOf(o.denom).IsPositive(), nil
+}
+
+// emptyLaunchError is returned with --require-nonempty when the launch has nothing to show.
+type emptyLaunchError struct {
+	launchID uint64
+	what     string
+}
+
+// Error implements error.
+func (e emptyLaunchError) Error() string {
+	return fmt.Sprintf("launch %d has no %s", e.launchID, e.what)
+}
+
+// checkNonEmpty returns an emptyLaunchError if the launch has no genesis accounts, including
+// the vesting ones if withVesting, or no genesis validators depending on the show type.
+func checkNonEmpty(
+	ctx context.Context,
+	gi genesisInformationFetcher,
+	launchID uint64,
+	showType ShowType,
+	withVesting bool,
+) error {
+	genesisInformation, err := gi.GenesisInformation(ctx, launchID)
+	if err != nil {
+		return err
+	}
+
+	switch showType {
+	case chainShowAccounts:
+		count := len(genesisInformation.GenesisAccounts)
+		if withVesting {
+			count += len(genesisInformation.VestingAccounts)
+		}
+		if count == 0 {
+			return emptyLaunchError{launchID: launchID, what: "genesis accounts"}
+		}
+	case chainShowValidators:
+		if len(genesisInformation.GenesisValidators) == 0 {
+			return emptyLaunchError{launchID: launchID, what: "genesis validators"}
+		}
+	}
+	return nil
+}
+
+// errStreamLimit stops the accounts stream once the limit of accounts is written.
+var errStreamLimit = errors.New("accounts limit reached")
+
+// genesisAccountsStreamer streams the genesis accounts of a launch.
+type genesisAccountsStreamer interface {
+	StreamGenesisAccounts(ctx context.Context, launchID uint64, fn func(networktypes.GenesisAccount) error) error
+}
+
+// formatDuplicateAccounts returns the genesis accounts sharing their address along with an error if any.
+func formatDuplicateAccounts(
+	ctx context.Context,
+	duplicates []networktypes.GenesisAccount,
+	output string,
+	table tableOptions,
+) (string, error) {
+	var err error
+	if count := addressCount(duplicates); count > 0 {
+		err = fmt.Errorf("%d addresses are used by several genesis accounts", count)
+	}
+
+	if isStructuredOutput(output) {
+		summary, fmtErr := formatStructured(ctx, output, duplicates)
+		if fmtErr != nil {
+			return "", fmtErr
+		}
+		return summary, err
+	}
+	if len(duplicates) == 0 {
+		return "no duplicated genesis account", nil
+	}
+
+	entries := make([][]string, 0, len(duplicates))
+	for _, acc := range duplicates {
+		entries = append(entries, accountEntry(acc))
+	}
+	var summary strings.Builder
+	if fmtErr := writeTable(&summary, output, table, chainAccSummaryHeader, entries...); fmtErr != nil {
+		return "", fmtErr
+	}
+	return summary.String(), err
+}
+
+// genesisChange is a genesis account or validator added, removed or changed since the base launch.
+type genesisChange struct {
+	Change  string `json:"change"`
+	Address string `json:"address"`
+	From    string `json:"from"`
+	To      string `json:"to"`
+}
+
+// formatGenesisDiff returns the changes of the genesis accounts, or of the genesis validators
+// for the validators show type, of the launch since the base launch, grouped by change type.
+// The coins of the accounts and the self delegations of the validators are compared.
+func formatGenesisDiff(
+	ctx context.Context,
+	gi genesisInformationFetcher,
+	baseLaunchID,
+	launchID uint64,
+	showType ShowType,
+	output string,
+	table tableOptions,
+) (string, error) {
+	base, err := gi.GenesisInformation(ctx, baseLaunchID)
+	if err != nil {
+		return "", err
+	}
+	genesisInformation, err := gi.GenesisInformation(ctx, launchID)
+	if err != nil {
+		return "", err
+	}
+	diff := network.DiffGenesisInformation(base, genesisInformation)
+
+	changes := make([]genesisChange, 0)
+	if showType == chainShowAccounts {
+		for _, acc := range diff.AccountsAdded {
+			changes = append(changes, genesisChange{Change: "added", Address: acc.Address, To: acc.Coins})
+		}
+		for _, acc := range diff.AccountsRemoved {
+			changes = append(changes, genesisChange{Change: "removed", Address: acc.Address, From: acc.Coins})
+		}
+		for _, c := range diff.CoinsChanged {
+			changes = append(changes, genesisChange{Change: "changed", Address: c.Address, From: c.From, To: c.To})
+		}
+	} else {
+		for _, val := range diff.ValidatorsAdded {
+			changes = append(changes, genesisChange{Change: "added", Address: val.Address, To: val.SelfDelegation})
+		}
+		for _, val := range diff.ValidatorsRemoved {
+			changes = append(changes, genesisChange{Change: "removed", Address: val.Address, From: val.SelfDelegation})
+		}
+	}
+
+	if isStructuredOutput(output) {
+		return formatStructured(ctx, output, changes)
+	}
+	if len(changes) == 0 {
+		return fmt.Sprintf("no %s change since launch %d", showType, baseLaunchID), nil
+	}
+
+	entries := make([][]string, 0, len(changes))
+	for _, c := range changes {
+		entries = append(entries, []string{c.Change, c.Address, c.From, c.To})
+	}
+	var summary strings.Builder
+	if err := writeTable(&summary, output, table, chainDiffHeader, entries...); err != nil {
+		return "", err
+	}
+	return summary.String(), nil
+}
+
+// formatVestingAudit returns the vesting accounts whose vesting coins don't match their balance
+// along with an error if any.
+func formatVestingAudit(
+	ctx context.Context,
+	discrepancies []network.VestingDiscrepancy,
+	output string,
+	table tableOptions,
+) (string, error) {
+	var err error
+	if len(discrepancies) > 0 {
+		err = fmt.Errorf("%d vesting accounts don't match their balance", len(discrepancies))
+	}
+
+	if isStructuredOutput(output) {
+		type discrepancySummary struct {
+			Address      string `json:"address"`
+			TotalBalance string `json:"totalBalance"`
+			Vesting      string `json:"vesting"`
+			Issue        string `json:"issue"`
+		}
+		summaries := make([]discrepancySummary, 0, len(discrepancies))
+		for _, d := range discrepancies {
+			summaries = append(summaries, discrepancySummary{
+				Address:      d.Address,
+				TotalBalance: d.TotalBalance,
+				Vesting:      d.Vesting,
+				Issue:        d.Issue,
+			})
+		}
+		summary, fmtErr := formatStructured(ctx, output, summaries)
+		if fmtErr != nil {
+			return "", fmtErr
+		}
+		return summary, err
+	}
+	if len(discrepancies) == 0 {
+		return "no vesting account discrepancy", nil
+	}
+
+	entries := make([][]string, 0, len(discrepancies))
+	for _, d := range discrepancies {
+		entries = append(entries, []string{d.Address, d.TotalBalance, d.Vesting, d.Issue})
+	}
+	var summary strings.Builder
+	if fmtErr := writeTable(&summary, output, table, chainAccAuditHeader, entries...); fmtErr != nil {
+		return "", fmtErr
+	}
+	return summary.String(), err
+}
+
+// addressCount returns the number of distinct addresses of the accounts.
+func addressCount(accounts []networktypes.GenesisAccount) int {
+	addresses := make(map[string]struct{})
+	for _, acc := range accounts {
+		addresses[acc.Address] = struct{}{}
+	}
+	return len(addresses)
+}
+
+// streamChainAccounts writes into out the genesis accounts of the chain in the CSV format, or as JSON
+// lines with the NDJSON output, as they are fetched. The accounts are filtered and paginated like
+// the accounts returned by formatChainAccounts.
+func streamChainAccounts(
+	ctx context.Context,
+	out io.Writer,
+	s genesisAccountsStreamer,
+	launchID uint64,
+	output string,
+	options accountsOptions,
+) error {
+	var (
+		w   *entrywriter.CSVWriter
+		enc = json.NewEncoder(out)
+		err error
+	)
+	if output != outputNDJSON {
+		if w, err = entrywriter.NewCSVWriter(out, !options.noHeader, options.header()); err != nil {
+			return err
+		}
+	}
+
+	var streamed, matched uint64
+	err = s.StreamGenesisAccounts(ctx, launchID, func(acc networktypes.GenesisAccount) error {
+		streamed++
+		// the address is matched with its new prefix like the accounts that aren't streamed
+		if options.bech32Prefix != "" {
+			var note string
+			if acc.Address, note = changeAddressPrefix(acc.Address, options.bech32Prefix); note != "" {
+				fmt.Fprintln(warningsOut(options.errOut), note)
+			}
+		}
+		ok, err := options.matches(acc)
+		if err != nil || !ok {
+			return err
+		}
+		matched++
+		if matched <= options.offset {
+			return nil
+		}
+		if options.limit > 0 && matched > options.offset+options.limit {
+			return errStreamLimit
+		}
+
+		if w == nil {
+			return enc.Encode(acc)
+		}
+		return w.Write(options.entry(acc, nil))
+	})
+	if err != nil && !errors.Is(err, errStreamLimit) {
+		return err
+	}
+	if w != nil {
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+	if options.requireNonEmpty && streamed == 0 {
+		return emptyLaunchError{launchID: launchID, what: "genesis accounts"}
+	}
+	return nil
+}
+
+// formatChainAccounts returns the list of genesis accounts of the chain.
+func formatChainAccounts(
+	ctx context.Context,
+	gi genesisInformationFetcher,
+	launchID uint64,
+	output string,
+	options accountsOptions,
+) (string, error) {
+	genesisInformation, err := gi.GenesisInformation(ctx, launchID)
+	if err != nil {
+		return "", err
+	}
+
+	// the chain can't start with duplicated addresses
+	duplicates := network.DuplicateGenesisAccounts(genesisInformation)
+	if options.checkDupes {
+		return formatDuplicateAccounts(ctx, duplicates, output, options.tableOptions)
+	}
+	if options.auditVesting {
+		return formatVestingAudit(ctx, network.AuditVestingAccounts(genesisInformation), output, options.tableOptions)
+	}
+	if count := addressCount(duplicates); count > 0 {
+		fmt.Fprintf(warningsOut(options.errOut), "warning: %d addresses are used by several genesis accounts, use --%s to list them\n",
+			count,
+			flagCheckDupes,
+		)
+	}
+
+	// the vesting accounts are listed with their total balance along the other accounts
+	chainAccounts := network.ChainAccountsSummary(ctx, genesisInformation, options.vesting)
+	if options.bech32Prefix != "" {
+		var untouched []string
+		chainAccounts, untouched = changeAccountsPrefix(chainAccounts, options.bech32Prefix)
+		for _, note := range untouched {
+			fmt.Fprintln(warningsOut(options.errOut), note)
+		}
+	}
+	allAccounts, vestingAccounts := chainAccounts.Accounts, chainAccounts.Vesting
+
+	if options.denoms {
+		return formatAccountsDenoms(ctx, allAccounts, output, options.tableOptions)
+	}
+	if options.groupBy != "" {
+		return formatAccountsGroups(ctx, allAccounts, options.groupBy, output, options.tableOptions)
+	}
+
+	accounts, total, err := options.filter(allAccounts)
+	if err != nil {
+		return "", err
+	}
+
+	if isStructuredOutput(output) {
+		if !options.vesting && options.mine == nil {
+			return formatStructured(ctx, output, accounts)
+		}
+
+		type accountSummary struct {
+			networktypes.GenesisAccount `yaml:",inline"`
+			VestingType                 string `json:"vestingType,omitempty"`
+			VestingEnd                  string `json:"vestingEnd,omitempty"`
+			Mine                        *bool  `json:"mine,omitempty" yaml:",omitempty"`
+		}
+		summaries := make([]accountSummary, 0)
+		for _, acc := range accounts {
+			summary := accountSummary{GenesisAccount: acc}
+			if options.vesting {
+				summary.VestingType, summary.VestingEnd = accountVesting(vestingAccounts, acc.Address)
+			}
+			if options.mine != nil {
+				mine := isMine(options.mine, acc.Address)
+				summary.Mine = &mine
+			}
+			summaries = append(summaries, summary)
+		}
+		return formatStructured(ctx, output, summaries)
+	}
+
+	// the wide table has a column for each denom held by any account
+	var denoms []string
+	if options.wide {
+		totals, err := genesisAccountsTotals(allAccounts)
+		if err != nil {
+			return "", err
+		}
+		for _, coin := range totals {
+			denoms = append(denoms, coin.Denom)
+		}
+	}
+
+	header := options.header()
+	if options.wide {
+		header = []string{chainAccSummaryHeader[0]}
+		for _, denom := range denoms {
+			if display, ok := options.displays[denom]; ok && options.humanize {
+				denom = display.denom
+			}
+			header = append(header, denom)
+		}
+		if options.vesting {
+			header = append(header, chainAccVestingHeader...)
+		}
+		if options.mine != nil {
+			header = append(header, chainAccMineHeader)
+		}
+	}
+
+	genesisAccEntries := make([][]string, 0)
+	for _, acc := range accounts {
+		entry := options.entry(acc, vestingAccounts)
+		if options.wide {
+			coins, err := sdk.ParseCoinsNormalized(acc.Coins)
+			if err != nil {
+				return "", errors.Wrapf(err, "invalid coins for account %s", acc.Address)
+			}
+			// the coins column is replaced with a column for each denom
+			wideEntry := []string{acc.Address}
+			for _, denom := range denoms {
+				amount := coins.AmountOf(denom).String()
+				if options.humanize {
+					amount, _ = humanizeAmount(denom, coins.AmountOf(denom), options.displays, options.si, options.separators)
+				}
+				wideEntry = append(wideEntry, amount)
+			}
+			entry = append(wideEntry, entry[2:]...)
+		}
+		genesisAccEntries = append(genesisAccEntries, entry)
+	}
+
+	var accSummary strings.Builder
+	if options.csv {
+		write := entrywriter.WriteCSV
+		if options.noHeader {
+			write = entrywriter.WriteCSVNoHeader
+		}
+		if err := write(&accSummary, header, genesisAccEntries...); err != nil {
+			return "", err
+		}
+		return accSummary.String(), nil
+	}
+	if err := writeTable(&accSummary, output, options.tableOptions, header, genesisAccEntries...); err != nil {
+		return "", err
+	}
+	if len(accounts) < total {
+		fmt.Fprintf(&accSummary, "showing %d of %d accounts\n", len(accounts), total)
+	}
+
+	if options.totals {
+		totals, err := genesisAccountsTotals(allAccounts)
+		if err != nil {
+			return "", err
+		}
+
+		totalEntries := make([][]string, 0)
+		for _, coin := range totals {
+			totalEntries = append(totalEntries, []string{coin.Denom, coin.Amount.String()})
+		}
+		accSummary.WriteString("\n")
+		if err := writeTable(&accSummary, output, options.tableOptions, chainAccTotalsHeader, totalEntries...); err != nil {
+			return "", err
+		}
+	}
+	return accSummary.String(), nil
+}
+
+// denomDisplay is the display denom of a base denom with its exponent from the genesis metadata.
+type denomDisplay struct {
+	denom    string
+	exponent uint32
+}
+
+// genesisDenomDisplays returns the display denoms of the base denoms from the metadata of the genesis
+// in the chain home, none are returned if the chain isn't initialized locally.
+func genesisDenomDisplays(home string) map[string]denomDisplay {
+	displays := make(map[string]denomDisplay)
+	genesis, err := cosmosutil.ParseGenesis(filepath.Join(home, "config", "genesis.json"))
+	if err != nil {
+		return displays
+	}
+	for _, metadata := range genesis.AppState.Bank.DenomMetadata {
+		if exponent, ok := metadata.DisplayExponent(); ok {
+			displays[metadata.Base] = denomDisplay{denom: metadata.Display, exponent: exponent}
+		}
+	}
+	return displays
+}
+
+// siSuffixes are the suffixes of the successive powers of 1000.
+var siSuffixes = []string{"k", "M", "G", "T", "P", "E"}
+
+// humanizeCoins formats the amounts of the coins with the separators, or SI suffixes if si,
+// in their display denom when known. The coins are returned unchanged if they are invalid.
+func humanizeCoins(coins string, displays map[string]denomDisplay, si bool, separators numberSeparators) string {
+	parsed, err := sdk.ParseCoinsNormalized(coins)
+	if err != nil {
+		return coins
+	}
+	humanized := make([]string, 0, len(parsed))
+	for _, coin := range parsed {
+		amount, denom := humanizeAmount(coin.Denom, coin.Amount, displays, si, separators)
+		humanized = append(humanized, amount+" "+denom)
+	}
+	return strings.Join(humanized, ", ")
+}
+
+// humanizeAmount returns the formatted amount of the denom with the denom it is expressed in.
+func humanizeAmount(
+	denom string,
+	amount sdk.Int,
+	displays map[string]denomDisplay,
+	si bool,
+	separators numberSeparators,
+) (string, string) {
+	var exponent uint32
+	if display, ok := displays[denom]; ok {
+		denom, exponent = display.denom, display.exponent
+	}
+	integer, fraction := shiftDecimal(amount.String(), int(exponent))
+	if si {
+		if humanized, ok := siAmount(integer, fraction); ok {
+			return strings.Replace(humanized, ".", separators.decimal, 1), denom
+		}
+	}
+	humanized := groupThousands(integer, separators.group)
+	if fraction != "" {
+		humanized += separators.decimal + fraction
+	}
+	return humanized, denom
+}
+
+// numberSeparators are the separators of the digit groups and of the decimals of a humanized amount.
+type numberSeparators struct {
+	group   string
+	decimal string
+}
+
+// defaultSeparators separates the thousands with commas and the decimals with a dot.
+var defaultSeparators = numberSeparators{group: ",", decimal: "."}
+
+// localeSeparators returns the separators of the locale, read from a number formatted for it.
+// The digits are always grouped by thousands, even when the locale groups them differently.
+func localeSeparators(locale language.Tag) numberSeparators {
+	formatted := message.NewPrinter(locale).Sprint(number.Decimal(1234567.5, number.MinFractionDigits(1)))
+
+	// the separators are the runs of non digits between the digits
+	var separators []string
+	sep := ""
+	for _, r := range formatted {
+		if unicode.IsDigit(r) {
+			if sep != "" {
+				separators = append(separators, sep)
+				sep = ""
+			}
+			continue
+		}
+		sep += string(r)
+	}
+	switch len(separators) {
+	case 0:
+		return numberSeparators{decimal: defaultSeparators.decimal}
+	case 1:
+		return numberSeparators{decimal: separators[0]}
+	default:
+		return numberSeparators{group: separators[0], decimal: separators[len(separators)-1]}
+	}
+}
+
+// shiftDecimal divides the decimal digits by 10^exponent and returns the integer part
+// with the fraction part without its trailing zeros.
+func shiftDecimal(digits string, exponent int) (integer, fraction string) {
+	if len(digits) <= exponent {
+		digits = strings.Repeat("0", exponent-len(digits)+1) + digits
+	}
+	split := len(digits) - exponent
+	return digits[:split], strings.TrimRight(digits[split:], "0")
+}
+
+// groupThousands separates the thousands of the integer digits with sep.
+func groupThousands(digits, sep string) string {
+	var b strings.Builder
+	for i, digit := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			b.WriteString(sep)
+		}
+		b.WriteRune(digit)
+	}
+	return b.String()
+}
+
+// siAmount returns the amount with one decimal and the SI suffix of its power of 1000,
+// it fails when the amount is below 1000.
+func siAmount(integer, fraction string) (string, bool) {
+	value := integer
+	if fraction != "" {
+		value += "." + fraction
+	}
+	f, ok := new(big.Float).SetString(value)
+	if !ok {
+		return "", false
+	}
+	thousand := big.NewFloat(1000)
+	suffix := -1
+	for f.Cmp(thousand) >= 0 && suffix < len(siSuffixes)-1 {
+		f.Quo(f, thousand)
+		suffix++
+	}
+	if suffix < 0 {
+		return "", false
+	}
+	return f.Text('f', 1) + siSuffixes[suffix], true
+}
+
+// accountEntry returns the table entry of a genesis account.
+func accountEntry(acc networktypes.GenesisAccount) []string {
+	return []string{acc.Address, acc.Coins}
+}
+
+// keyringAddresses returns the SPN addresses of the keys in the keyring. The keyring only stores
+// the derived keys, so the addresses are the ones of the coin type each key was created with and
+// the coin type of the chain isn't applied.
+func keyringAddresses(registry cosmosaccount.Registry) (map[string]struct{}, error) {
+	accounts, err := registry.List()
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot list the keyring accounts")
+	}
+	addresses := make(map[string]struct{})
+	for _, acc := range accounts {
+		addresses[acc.Address(networkchain.SPN)] = struct{}{}
+	}
+	return addresses, nil
+}
+
+const (
+	accountColumnAddress    = "address"
+	accountColumnCoins      = "coins"
+	accountColumnVesting    = "vesting"
+	accountColumnVestingEnd = "vesting-end"
+	accountColumnMine       = "mine"
+)
+
+// accountColumn is a column of the accounts table selectable with --columns.
+type accountColumn struct {
+	header string
+	cell   func(acc networktypes.GenesisAccount, vestingAccounts map[string]networktypes.VestingAccount, mine map[string]struct{}) string
+}
+
+// accountColumns are the columns of the accounts table by name.
+var accountColumns = map[string]accountColumn{
+	accountColumnAddress: {
+		header: "Address",
+		cell: func(acc networktypes.GenesisAccount, _ map[string]networktypes.VestingAccount, _ map[string]struct{}) string {
+			return acc.Address
+		},
+	},
+	accountColumnCoins: {
+		header: "Coins",
+		cell: func(acc networktypes.GenesisAccount, _ map[string]networktypes.VestingAccount, _ map[string]struct{}) string {
+			return acc.Coins
+		},
+	},
+	accountColumnVesting: {
+		header: "Vesting",
+		cell: func(acc networktypes.GenesisAccount, vestingAccounts map[string]networktypes.VestingAccount, _ map[string]struct{}) string {
+			vestingType, _ := accountVesting(vestingAccounts, acc.Address)
+			return vestingType
+		},
+	},
+	accountColumnVestingEnd: {
+		header: "Vesting End",
+		cell: func(acc networktypes.GenesisAccount, vestingAccounts map[string]networktypes.VestingAccount, _ map[string]struct{}) string {
+			_, vestingEnd := accountVesting(vestingAccounts, acc.Address)
+			return vestingEnd
+		},
+	},
+	accountColumnMine: {
+		header: chainAccMineHeader,
+		cell: func(acc networktypes.GenesisAccount, _ map[string]networktypes.VestingAccount, mine map[string]struct{}) string {
+			return strconv.FormatBool(isMine(mine, acc.Address))
+		},
+	},
+}
+
+// normalizeColumn returns the name of the column as used in accountColumns.
+func normalizeColumn(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// validateAccountColumns checks that the columns of the accounts table exist.
+func validateAccountColumns(columns []string) error {
+	for _, column := range columns {
+		if _, ok := accountColumns[normalizeColumn(column)]; !ok {
+			valid := make([]string, 0, len(accountColumns))
+			for name := range accountColumns {
+				valid = append(valid, name)
+			}
+			sort.Strings(valid)
+			return fmt.Errorf("invalid column %s, use one of: %s", column, strings.Join(valid, ", "))
+		}
+	}
+	return nil
+}
+
+// hasVestingColumn checks if one of the columns shows the vesting of the accounts.
+func hasVestingColumn(columns []string) bool {
+	for _, column := range columns {
+		if name := normalizeColumn(column); name == accountColumnVesting || name == accountColumnVestingEnd {
+			return true
+		}
+	}
+	return false
+}
+
+// changeAccountsPrefix returns the accounts with their addresses re-encoded with the prefix
+// and a note for each address left untouched because it is a module or an invalid address.
+func changeAccountsPrefix(chainAccounts network.ChainAccounts, prefix string) (network.ChainAccounts, []string) {
+	var (
+		notes   []string
+		changed = network.ChainAccounts{
+			Accounts: make([]networktypes.GenesisAccount, 0, len(chainAccounts.Accounts)),
+			Vesting:  make(map[string]networktypes.VestingAccount),
+		}
+	)
+	for _, acc := range chainAccounts.Accounts {
+		address, note := changeAddressPrefix(acc.Address, prefix)
+		if note != "" {
+			notes = append(notes, note)
+		}
+
+		if vestingAcc, ok := chainAccounts.Vesting[acc.Address]; ok {
+			vestingAcc.Address = address
+			changed.Vesting[address] = vestingAcc
+		}
+		acc.Address = address
+		changed.Accounts = append(changed.Accounts, acc)
+	}
+	return changed, notes
+}
+
+// changeAddressPrefix returns the address re-encoded with the prefix, or the address
+// with a note explaining why it is left untouched.
+func changeAddressPrefix(address, prefix string) (newAddress, note string) {
+	if cosmosutil.IsModuleAddress(address) {
+		return address, fmt.Sprintf("%s left untouched: module account", address)
+	}
+	newAddress, err := cosmosutil.ChangeAddressPrefix(address, prefix)
+	if err != nil {
+		return address, fmt.Sprintf("%s left untouched: %s", address, err)
+	}
+	return newAddress, ""
+}
+
+// isMine tells if the address belongs to one of the keys whatever its prefix.
+func isMine(mine map[string]struct{}, address string) bool {
+	spnAddress, err := cosmosutil.ChangeAddressPrefix(address, networkchain.SPN)
+	if err != nil {
+		return false
+	}
+	_, ok := mine[spnAddress]
+	return ok
+}
+
+// accountVesting returns the vesting type and end time of the account, both are empty for a plain account.
+// The vesting accounts are all delayed, see vestingTypeDelayed.
+func accountVesting(vestingAccounts map[string]networktypes.VestingAccount, address string) (vestingType, vestingEnd string) {
+	acc, ok := vestingAccounts[address]
+	if !ok {
+		return "", ""
+	}
+	return vestingTypeDelayed, time.Unix(acc.EndTime, 0).UTC().Format(time.RFC3339)
+}
+
+// formatAccountsDenoms returns the sorted denoms held by the accounts with the number of accounts holding each.
+func formatAccountsDenoms(
+	ctx context.Context,
+	accounts []networktypes.GenesisAccount,
+	output string,
+	table tableOptions,
+) (string, error) {
+	holders := make(map[string]int)
+	for _, acc := range accounts {
+		coins, err := sdk.ParseCoinsNormalized(acc.Coins)
+		if err != nil {
+			return "", errors.Wrapf(err, "invalid coins for account %s", acc.Address)
+		}
+		for _, coin := range coins {
+			if coin.IsPositive() {
+				holders[coin.Denom]++
+			}
+		}
+	}
+
+	denoms := make([]string, 0, len(holders))
+	for denom := range holders {
+		denoms = append(denoms, denom)
+	}
+	sort.Strings(denoms)
+
+	if isStructuredOutput(output) {
+		type denomSummary struct {
+			Denom    string `json:"denom"`
+			Accounts int    `json:"accounts"`
+		}
+		summaries := make([]denomSummary, 0, len(denoms))
+		for _, denom := range denoms {
+			summaries = append(summaries, denomSummary{Denom: denom, Accounts: holders[denom]})
+		}
+		return formatStructured(ctx, output, summaries)
+	}
+
+	denomEntries := make([][]string, 0, len(denoms))
+	for _, denom := range denoms {
+		denomEntries = append(denomEntries, []string{denom, strconv.Itoa(holders[denom])})
+	}
+	var denomsSummary strings.Builder
+	if err := writeTable(&denomsSummary, output, table, chainAccDenomsHeader, denomEntries...); err != nil {
+		return "", err
+	}
+	return denomsSummary.String(), nil
+}
+
+const (
+	groupByDenom       = "denom"
+	groupByHolderClass = "holder-class"
+)
+
+// accountsGroup is the number of accounts holding a denom with their total amount,
+// the class is only set when the accounts are grouped by holder class.
+type accountsGroup struct {
+	Denom    string `json:"denom"`
+	Class    string `json:"class,omitempty" yaml:",omitempty"`
+	Accounts int    `json:"accounts"`
+	Total    string `json:"total"`
+
+	magnitude int
+	total     sdk.Int
+}
+
+// groupAccounts groups the balances of the accounts by denom, and by holder class with
+// groupByHolderClass, sorted by denom and class magnitude.
+func groupAccounts(accounts []networktypes.GenesisAccount, groupBy string) ([]accountsGroup, error) {
+	groups := make(map[string]*accountsGroup)
+	for _, acc := range accounts {
+		coins, err := sdk.ParseCoinsNormalized(acc.Coins)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid coins for account %s", acc.Address)
+		}
+		for _, coin := range coins {
+			if !coin.IsPositive() {
+				continue
+			}
+			key, group := coin.Denom, accountsGroup{Denom: coin.Denom, total: sdk.ZeroInt()}
+			if groupBy == groupByHolderClass {
+				group.magnitude, group.Class = holderClass(coin.Amount)
+				key = fmt.Sprintf("%s/%d", coin.Denom, group.magnitude)
+			}
+			if _, ok := groups[key]; !ok {
+				groups[key] = &group
+			}
+			groups[key].Accounts++
+			groups[key].total = groups[key].total.Add(coin.Amount)
+		}
+	}
+
+	sorted := make([]accountsGroup, 0, len(groups))
+	for _, group := range groups {
+		group.Total = group.total.String()
+		sorted = append(sorted, *group)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Denom != sorted[j].Denom {
+			return sorted[i].Denom < sorted[j].Denom
+		}
+		return sorted[i].magnitude < sorted[j].magnitude
+	})
+	return sorted, nil
+}
+
+// holderClass returns the power of 1000 of the amount with the label of its class, e.g. 1k-999k,
+// the amounts above the largest SI suffix share the last class.
+func holderClass(amount sdk.Int) (int, string) {
+	magnitude := (len(amount.String()) - 1) / 3
+	switch {
+	case magnitude == 0:
+		return 0, "1-999"
+	case magnitude > len(siSuffixes):
+		magnitude = len(siSuffixes)
+	}
+	suffix := siSuffixes[magnitude-1]
+	if magnitude == len(siSuffixes) {
+		return magnitude, fmt.Sprintf("1%s+", suffix)
+	}
+	return magnitude, fmt.Sprintf("1%s-999%s", suffix, suffix)
+}
+
+// formatAccountsGroups returns the accounts grouped by denom or by holder class with their total amount.
+func formatAccountsGroups(
+	ctx context.Context,
+	accounts []networktypes.GenesisAccount,
+	groupBy,
+	output string,
+	table tableOptions,
+) (string, error) {
+	groups, err := groupAccounts(accounts, groupBy)
+	if err != nil {
+		return "", err
+	}
+	if isStructuredOutput(output) {
+		return formatStructured(ctx, output, groups)
+	}
+
+	header := chainAccGroupsHeader
+	if groupBy == groupByHolderClass {
+		header = chainAccClassesHeader
+	}
+	entries := make([][]string, 0, len(groups))
+	for _, group := range groups {
+		entry := []string{group.Denom, strconv.Itoa(group.Accounts), group.Total}
+		if groupBy == groupByHolderClass {
+			entry = []string{group.Denom, group.Class, strconv.Itoa(group.Accounts), group.Total}
+		}
+		entries = append(entries, entry)
+	}
+	var groupsSummary strings.Builder
+	if err := writeTable(&groupsSummary, output, table, header, entries...); err != nil {
+		return "", err
+	}
+	return groupsSummary.String(), nil
+}
+
+// genesisAccountsTotals sums the coins of all the genesis accounts.
+func genesisAccountsTotals(accounts []networktypes.GenesisAccount) (sdk.Coins, error) {
+	totals := sdk.NewCoins()
+	for _, acc := range accounts {
+		coins, err := sdk.ParseCoinsNormalized(acc.Coins)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid coins for account %s", acc.Address)
+		}
+		totals = totals.Add(coins...)
+	}
+	return totals, nil
+}
+
+// formatChainValidators returns the list of genesis validators of the chain.
+func formatChainValidators(
+	ctx context.Context,
+	gi genesisInformationFetcher,
+	launchID uint64,
+	output string,
+	options validatorsOptions,
+) (string, error) {
+	validators, err := chainValidators(ctx, gi, launchID)
+	if err != nil {
+		return "", err
+	}
+
+	header := chainValSummaryHeader
+	var votingPower network.VotingPower
+	if options.power {
+		genesisInformation, err := gi.GenesisInformation(ctx, launchID)
+		if err != nil {
+			return "", err
+		}
+		if votingPower, err = network.ValidatorsVotingPower(genesisInformation); err != nil {
+			return "", err
+		}
+		header = append(append([]string{}, header...), chainValPowerHeader)
+		for i := range validators {
+			share := votingPower.Shares[i]
+			validators[i].Power = &share
+		}
+	}
+	if options.mine != nil {
+		header = append(append([]string{}, header...), chainAccMineHeader)
+		for i := range validators {
+			mine := isMine(options.mine, validators[i].Address)
+			validators[i].Mine = &mine
+		}
+	}
+
+	if output == outputJSON || output == outputNDJSON {
+		return formatStructured(ctx, output, validators)
+	}
+
+	var valSummary strings.Builder
+	if err := writeTable(&valSummary, output, options.tableOptions, header, validatorEntries(validators)...); err != nil {
+		return "", err
+	}
+	if options.power && len(validators) > 0 {
+		fmt.Fprintf(&valSummary, "\n%d of %d validators hold more than a third of the voting power",
+			votingPower.Controlling,
+			len(validators),
+		)
+	}
+	return valSummary.String(), nil
+}
+
+// validatorsOptions configures how the genesis validators are shown.
+type validatorsOptions struct {
+	tableOptions
+
+	// mine holds the SPN addresses of the local keys to annotate the validators with,
+	// the validators aren't annotated if nil.
+	mine map[string]struct{}
+
+	// power adds the share of each validator in the voting power with the number
+	// of validators holding more than a third of it.
+	power bool
+}
+
+// validatorSummary is a genesis validator of the chain as shown.
+type validatorSummary struct {
+	Address        string `json:"address"`
+	SelfDelegation string `json:"selfDelegation"`
+	Peer           string `json:"peer"`
+	GentxHash      string `json:"gentxHash"`
+
+	// Power is the share of the validator in the total self delegation, only set with --power.
+	Power *float64 `json:"power,omitempty"`
+
+	// Mine is only set when the validators are annotated with the local keys.
+	Mine *bool `json:"mine,omitempty"`
+}
+
+// chainValidators returns the genesis validators of the chain with the hash of their gentx.
+func chainValidators(ctx context.Context, gi genesisInformationFetcher, launchID uint64) ([]validatorSummary, error) {
+	genesisInformation, err := gi.GenesisInformation(ctx, launchID)
+	if err != nil {
+		return nil, err
+	}
+
+	validators := make([]validatorSummary, 0)
+	for _, val := range genesisInformation.GenesisValidators {
+		validators = append(validators, validatorSummary{
+			Address:        val.Address,
+			SelfDelegation: val.SelfDelegation,
+			Peer:           val.Peer,
+			GentxHash:      fmt.Sprintf("%x", sha256.Sum256(val.Gentx)),
+		})
+	}
+	return validators, nil
+}
+
+// validatorEntries returns the table entries of the validators.
+func validatorEntries(validators []validatorSummary) [][]string {
+	entries := make([][]string, 0, len(validators))
+	for _, val := range validators {
+		entry := []string{
+			val.Address,
+			val.SelfDelegation,
+			val.Peer,
+			val.GentxHash,
+		}
+		if val.Power != nil {
+			entry = append(entry, fmt.Sprintf("%.2f%%", *val.Power*100))
+		}
+		if val.Mine != nil {
+			entry = append(entry, strconv.FormatBool(*val.Mine))
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// formatChainGentxs returns the gentxs of the genesis validators of the chain.
+// Only the gentx of the validator with the provided address is returned when it is not empty.
+func formatChainGentxs(
+	ctx context.Context,
+	gi genesisInformationFetcher,
+	launchID uint64,
+	output,
+	validator string,
+) (string, error) {
+	genesisInformation, err := gi.GenesisInformation(ctx, launchID)
+	if err != nil {
+		return "", err
+	}
+
+	gentxs := make([]json.RawMessage, 0)
+	for _, val := range genesisInformation.GenesisValidators {
+		if validator != "" && val.Address != validator {
+			continue
+		}
+		if !json.Valid(val.Gentx) {
+			return "", fmt.Errorf("invalid gentx for validator %s", val.Address)
+		}
+		gentxs = append(gentxs, val.Gentx)
+	}
+	if validator != "" && len(gentxs) == 0 {
+		return "", fmt.Errorf("no genesis validator with the address %s", validator)
+	}
+
+	if output == outputJSON || output == outputNDJSON {
+		return formatStructured(ctx, output, gentxs)
+	}
+
+	formatted := make([]string, 0, len(gentxs))
+	for _, gentx := range gentxs {
+		gentxJSON, err := formatJSON(gentx)
+		if err != nil {
+			return "", err
+		}
+		formatted = append(formatted, gentxJSON)
+	}
+	return strings.Join(formatted, "\n---\n"), nil
+}
+
+// formatChainPeers returns the persistent peers of the chain validators.
+func formatChainPeers(
+	ctx context.Context,
+	gi genesisInformationFetcher,
+	launchID uint64,
+	output string,
+	options peersOptions,
+) (string, error) {
+	genesisInformation, err := gi.GenesisInformation(ctx, launchID)
+	if err != nil {
+		return "", err
+	}
+	if options.rpc {
+		return formatPeerRPCs(ctx, genesisInformation.GenesisValidators, output, options.errOut)
+	}
+
+	chainPeers := network.ChainPeersSummary(ctx, genesisInformation)
+	peers := chainPeers.Peers
+	if chainPeers.Invalid > 0 {
+		fmt.Fprintf(warningsOut(options.errOut), "%d invalid peers omitted\n", chainPeers.Invalid)
+	}
+
+	if options.check {
+		return formatPeersStatus(ctx, peers, output, options.tableOptions)
+	}
+	if options.addrbook != "" {
+		n, err := writeAddrbook(peers, options.addrbook, options.force, options.errOut)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s Address book written with %d peers: %s", clispinner.OK, n, options.addrbook), nil
+	}
+	if options.mergeConfig != "" {
+		return mergeConfigPeers(options.mergeConfig, peers, options.write)
+	}
+
+	if isStructuredOutput(output) {
+		return formatStructured(ctx, output, peers)
+	}
+	// the config snippets stay on a single line to be pasted
+	switch options.format {
+	case peersFormatPersistent:
+		return fmt.Sprintf("persistent_peers = %q", strings.Join(peers, ",")), nil
+	case peersFormatSeeds:
+		return fmt.Sprintf("seeds = %q", strings.Join(peers, ",")), nil
+	case peersFormatCSV:
+		return strings.Join(peers, ","), nil
+	}
+	return formatPeersTable(chainPeers, output, options)
+}
+
+// formatPeerRPCs returns the unique RPC endpoints of the validators comma separated, or as a list with the
+// structured outputs. The validators don't advertise an RPC address so the endpoint is the host of their peer
+// on the default RPC port, the validators without a valid peer are skipped with a warning written to errOut.
+func formatPeerRPCs(
+	ctx context.Context,
+	validators []networktypes.GenesisValidator,
+	output string,
+	errOut io.Writer,
+) (string, error) {
+	var (
+		endpoints = make([]string, 0)
+		seen      = make(map[string]struct{})
+		skipped   int
+	)
+	for _, val := range validators {
+		endpoint, err := peerRPCAddress(val.Peer)
+		if err != nil {
+			skipped++
+			continue
+		}
+		if _, ok := seen[endpoint]; ok {
+			continue
+		}
+		seen[endpoint] = struct{}{}
+		endpoints = append(endpoints, endpoint)
+	}
+	if skipped > 0 {
+		fmt.Fprintf(warningsOut(errOut), "warning: %d validators without a valid peer skipped\n", skipped)
+	}
+
+	if isStructuredOutput(output) {
+		return formatStructured(ctx, output, endpoints)
+	}
+	return strings.Join(endpoints, ","), nil
+}
+
+// copyToClipboard copies the text into the clipboard and writes a confirmation to errOut,
+// only a warning is written when the clipboard isn't available.
+func copyToClipboard(ctx context.Context, text string, errOut io.Writer) {
+	err := clipboard.Copy(ctx, text)
+	switch {
+	case errors.Is(err, clipboard.ErrUnavailable):
+		fmt.Fprintln(errOut, "warning: no clipboard available, the peers are only printed")
+	case err != nil:
+		fmt.Fprintf(errOut, "warning: cannot copy the peers into the clipboard: %s\n", err)
+	default:
+		fmt.Fprintf(errOut, "%s Peers copied into the clipboard\n", clispinner.OK)
+	}
+}
+
+// mergeConfigPeers merges the persistent peers of the config.toml with the peers and returns
+// the merged persistent_peers line, or writes the merged peers into the config.toml if write.
+func mergeConfigPeers(configPath string, peers []string, write bool) (string, error) {
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		return "", errors.Wrapf(err, "cannot read %s", configPath)
+	}
+	config, err := toml.LoadBytes(data)
+	if err != nil {
+		return "", errors.Wrapf(err, "cannot read %s", configPath)
+	}
+
+	var current []string
+	if persistentPeers, ok := config.Get("p2p.persistent_peers").(string); ok {
+		for _, peer := range strings.Split(persistentPeers, ",") {
+			if peer = strings.TrimSpace(peer); peer != "" {
+				current = append(current, peer)
+			}
+		}
+	}
+	merged := mergePeers(current, peers)
+	line := fmt.Sprintf("persistent_peers = %q", strings.Join(merged, ","))
+
+	if !write {
+		return line, nil
+	}
+
+	// only the persistent_peers line is replaced to keep the comments and the layout of the config,
+	// the config is written to a temporary file renamed over it so a failed write never truncates it
+	stat, err := os.Stat(configPath)
+	if err != nil {
+		return "", err
+	}
+	configFile, err := os.CreateTemp(filepath.Dir(configPath), filepath.Base(configPath)+".*.tmp")
+	if err != nil {
+		return "", err
+	}
+	_, err = configFile.Write(replaceConfigPeers(data, line))
+	if err == nil {
+		err = configFile.Chmod(stat.Mode())
+	}
+	if closeErr := configFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(configFile.Name(), configPath)
+	}
+	if err != nil {
+		os.Remove(configFile.Name())
+		return "", err
+	}
+	return fmt.Sprintf("%s %d persistent peers written: %s", clispinner.OK, len(merged), configPath), nil
+}
+
+// replaceConfigPeers returns the config with the persistent_peers line of its p2p table replaced by line.
+// The line is added at the top of the p2p table when it has no persistent_peers, and the p2p table
+// is added at the end of the config when it has none.
+func replaceConfigPeers(config []byte, line string) []byte {
+	var (
+		lines   = strings.Split(string(config), "\n")
+		table   string
+		p2pLine = -1
+	)
+	for i, l := range lines {
+		trimmed := strings.TrimSpace(l)
+		if strings.HasPrefix(trimmed, "[") {
+			table = strings.TrimSpace(strings.Trim(trimmed, "[]"))
+			if table == "p2p" {
+				p2pLine = i
+			}
+			continue
+		}
+		if table != "p2p" {
+			continue
+		}
+		if key := strings.SplitN(trimmed, "=", 2); len(key) == 2 && strings.TrimSpace(key[0]) == "persistent_peers" {
+			lines[i] = l[:len(l)-len(strings.TrimLeft(l, " \t"))] + line
+			return []byte(strings.Join(lines, "\n"))
+		}
+	}
+
+	if p2pLine < 0 {
+		return []byte(strings.TrimRight(string(config), "\n") + "\n\n[p2p]\n" + line + "\n")
+	}
+	lines = append(lines[:p2pLine+1], append([]string{line}, lines[p2pLine+1:]...)...)
+	return []byte(strings.Join(lines, "\n"))
+}
+
+// mergePeers returns the current peers followed by the new peers whose node ID isn't already
+// used by a peer, the address of the current peers is kept when their node ID is shared.
+func mergePeers(current, peers []string) []string {
+	var (
+		merged  = make([]string, 0, len(current)+len(peers))
+		nodeIDs = make(map[string]struct{})
+	)
+	for _, peer := range append(append([]string{}, current...), peers...) {
+		nodeID := peerNodeID(peer)
+		if _, ok := nodeIDs[nodeID]; ok {
+			continue
+		}
+		nodeIDs[nodeID] = struct{}{}
+		merged = append(merged, peer)
+	}
+	return merged
+}
+
+// peerNodeID returns the node ID of the peer, the peer itself if it has no address.
+func peerNodeID(peer string) string {
+	if i := strings.Index(peer, "@"); i >= 0 {
+		return peer[:i]
+	}
+	return peer
+}
+
+// peerKeySummary compares the node ID of the peer of a validator with the node ID of its gentx.
+type peerKeySummary struct {
+	Address     string `json:"address"`
+	PeerNodeID  string `json:"peerNodeID"`
+	GentxNodeID string `json:"gentxNodeID"`
+	Status      string `json:"status"`
+}
+
+// verifyPeerKeys cross-checks the node ID of the peer of each validator against the node ID
+// recorded in the memo of its gentx. A node ID is derived from the node key rather than the
+// consensus key, the gentx memo is the only record of it. The status is unknown when the
+// gentx can't be parsed or its memo has no node ID.
+func verifyPeerKeys(validators []networktypes.GenesisValidator) []peerKeySummary {
+	summaries := make([]peerKeySummary, 0, len(validators))
+	for _, val := range validators {
+		summary := peerKeySummary{
+			Address:    val.Address,
+			PeerNodeID: peerNodeID(val.Peer),
+			Status:     peerKeyUnknown,
+		}
+		if info, _, err := cosmosutil.ParseGentx(val.Gentx); err == nil && info.Memo != "" {
+			summary.GentxNodeID = peerNodeID(info.Memo)
+			summary.Status = peerKeyMismatch
+			if strings.EqualFold(summary.PeerNodeID, summary.GentxNodeID) {
+				summary.Status = peerKeyMatch
+			}
+		}
+		summaries = append(summaries, summary)
+	}
+	return summaries
+}
+
+// formatPeerKeys returns the node IDs of the peers of the genesis validators checked against their
+// gentx along with an error if any peer doesn't match its gentx.
+func formatPeerKeys(
+	ctx context.Context,
+	gi genesisInformationFetcher,
+	launchID uint64,
+	output string,
+	table tableOptions,
+) (string, error) {
+	genesisInformation, err := gi.GenesisInformation(ctx, launchID)
+	if err != nil {
+		return "", err
+	}
+
+	summaries := verifyPeerKeys(genesisInformation.GenesisValidators)
+	var mismatches int
+	for _, summary := range summaries {
+		if summary.Status == peerKeyMismatch {
+			mismatches++
+		}
+	}
+	if mismatches > 0 {
+		err = fmt.Errorf("%d validators advertise a peer whose node ID differs from their gentx", mismatches)
+	}
+
+	if isStructuredOutput(output) {
+		summary, fmtErr := formatStructured(ctx, output, summaries)
+		if fmtErr != nil {
+			return "", fmtErr
+		}
+		return summary, err
+	}
+
+	entries := make([][]string, 0, len(summaries))
+	for _, summary := range summaries {
+		entries = append(entries, []string{summary.Address, summary.PeerNodeID, summary.GentxNodeID, summary.Status})
+	}
+	var keysSummary strings.Builder
+	if fmtErr := writeTable(&keysSummary, output, table, chainPeerKeysHeader, entries...); fmtErr != nil {
+		return "", fmtErr
+	}
+	return keysSummary.String(), err
+}
+
+// formatPeersTable returns the peers sorted by moniker in a table paged with the options.
+func formatPeersTable(chainPeers network.ChainPeers, output string, options peersOptions) (string, error) {
+	peerEntries := make([][]string, 0, len(chainPeers.Peers))
+	for _, peer := range chainPeers.Peers {
+		nodeID, address := peer, ""
+		if i := strings.Index(peer, "@"); i >= 0 {
+			nodeID, address = peer[:i], peer[i+1:]
+		}
+		peerEntries = append(peerEntries, []string{chainPeers.Monikers[peer], nodeID, address})
+	}
+	sort.SliceStable(peerEntries, func(i, j int) bool {
+		return peerEntries[i][0] < peerEntries[j][0]
+	})
+
+	total := len(peerEntries)
+	if options.offset >= uint64(total) {
+		peerEntries = nil
+	} else {
+		peerEntries = peerEntries[options.offset:]
+		if options.limit > 0 && options.limit < uint64(len(peerEntries)) {
+			peerEntries = peerEntries[:options.limit]
+		}
+	}
+
+	var peersSummary strings.Builder
+	if err := writeTable(&peersSummary, output, options.tableOptions, chainPeersHeader, peerEntries...); err != nil {
+		return "", err
+	}
+	if len(peerEntries) < total {
+		fmt.Fprintf(&peersSummary, "showing %d of %d peers\n", len(peerEntries), total)
+	}
+	return peersSummary.String(), nil
+}
+
+// writeAddrbook writes the peers into a Tendermint address book at the path and returns
+// the number of peers written. The peers with an address that can't be resolved are skipped
+// with a warning written to errOut.
+func writeAddrbook(peers []string, path string, force bool, errOut io.Writer) (int, error) {
+	if !force {
+		_, err := os.Stat(path)
+		if err == nil {
+			return 0, fmt.Errorf("%s already exists, use --%s to overwrite it", path, flagForce)
+		}
+		if !os.IsNotExist(err) {
+			return 0, err
+		}
+	}
+
+	book := pex.NewAddrBook(path, false)
+	var added int
+	for _, peer := range peers {
+		addr, err := p2p.NewNetAddressString(peer)
+		if err != nil {
+			fmt.Fprintf(warningsOut(errOut), "peer %s omitted: %s\n", peer, err)
+			continue
+		}
+		if err := book.AddAddress(addr, addr); err != nil {
+			fmt.Fprintf(warningsOut(errOut), "peer %s omitted: %s\n", peer, err)
+			continue
+		}
+		added++
+	}
+
+	// the address book doesn't report the save errors, make sure the file is written
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return 0, err
+	}
+	book.Save()
+	if _, err := os.Stat(path); err != nil {
+		return 0, errors.Wrap(err, "cannot write the address book")
+	}
+	return added, nil
+}
+
+// formatPeersStatus checks the reachability of the peers and returns their status.
+func formatPeersStatus(ctx context.Context, peers []string, output string, table tableOptions) (string, error) {
+	statuses := checkPeers(ctx, peers)
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+
+	if isStructuredOutput(output) {
+		type peerStatus struct {
+			Peer   string `json:"peer"`
+			Status string `json:"status"`
+		}
+		peersStatus := make([]peerStatus, 0)
+		for i, peer := range peers {
+			peersStatus = append(peersStatus, peerStatus{Peer: peer, Status: statuses[i]})
+		}
+		return formatStructured(ctx, output, peersStatus)
+	}
+
+	peerEntries := make([][]string, 0)
+	for i, peer := range peers {
+		peerEntries = append(peerEntries, []string{peer, statuses[i]})
+	}
+
+	var peersSummary strings.Builder
+	if err := writeTable(&peersSummary, output, table, chainPeersCheckHeader, peerEntries...); err != nil {
+		return "", err
+	}
+	return peersSummary.String(), nil
+}
+
+// checkPeers dials the peers concurrently with a bounded number of workers
+// and returns the status of each peer in the same order.
+func checkPeers(ctx context.Context, peers []string) []string {
+	var (
+		statuses = make([]string, len(peers))
+		indexes  = make(chan int)
+		wg       sync.WaitGroup
+	)
+	for w := 0; w < peerCheckWorkers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				statuses[i] = checkPeer(ctx, peers[i])
+			}
+		}()
+	}
+
+	for i := range peers {
+		select {
+		case indexes <- i:
+		case <-ctx.Done():
+		}
+	}
+	close(indexes)
+	wg.Wait()
+	return statuses
+}
+
+// checkPeer dials the address of the peer in the id@host:port format.
+func checkPeer(ctx context.Context, peer string) string {
+	addr := peer[strings.Index(peer, "@")+1:]
+
+	ctx, cancel := context.WithTimeout(ctx, peerCheckTimeout)
+	defer cancel()
+
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		var netErr net.Error
+		if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()) {
+			return peerStatusTimeout
+		}
+		return peerStatusDown
+	}
+	conn.Close()
+	return peerStatusUp
+}
+
+// peersOptions configures how the peers are shown.
+type peersOptions struct {
+	tableOptions
+
+	format   string
+	check    bool
+	addrbook string
+	force    bool
+
+	// mergeConfig is the path of a config.toml whose persistent peers are merged with the peers,
+	// the merged peers are written into it with write.
+	mergeConfig string
+	write       bool
+
+	// limit and offset page the peers table.
+	limit  uint64
+	offset uint64
+
+	// rpc shows the RPC endpoints of the validators instead of their peers.
+	rpc bool
+
+	// errOut receives the warnings, they are discarded if nil.
+	errOut io.Writer
+}
+
+// isValidPeersFormat checks if the peers format is supported.
+func isValidPeersFormat(format string) bool {
+	for _, f := range peersFormats {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
+
+// formatChainRequests returns the requests of the chain with their type and status.
+func formatChainRequests(requests []launchtypes.Request, output string, table tableOptions) (string, error) {
+	type requestSummary struct {
+		RequestID uint64 `json:"requestID"`
+		Type      string `json:"type"`
+		Creator   string `json:"creator"`
+		Status    string `json:"status"`
+	}
+
+	summaries := make([]requestSummary, 0, len(requests))
+	for _, request := range requests {
+		summaries = append(summaries, requestSummary{
+			RequestID: request.RequestID,
+			Type:      requestType(request.Content),
+			Creator:   request.Creator,
+			// SPN removes the requests once they are approved or rejected
+			Status: requestStatusPending,
+		})
+	}
+
+	if output == outputJSON {
+		return formatJSON(summaries)
+	}
+
+	entries := make([][]string, 0, len(summaries))
+	for _, summary := range summaries {
+		entries = append(entries, []string{
+			strconv.FormatUint(summary.RequestID, 10),
+			summary.Type,
+			summary.Creator,
+			summary.Status,
+		})
+	}
+	var requestsSummary strings.Builder
+	if err := writeTable(&requestsSummary, output, table, chainRequestsHeader, entries...); err != nil {
+		return "", err
+	}
+	return requestsSummary.String(), nil
+}
+
+// requestType returns the type of the request content.
+func requestType(content launchtypes.RequestContent) string {
+	switch content.Content.(type) {
+	case *launchtypes.RequestContent_GenesisAccount:
+		return "add-account"
+	case *launchtypes.RequestContent_VestingAccount:
+		return "add-vesting-account"
+	case *launchtypes.RequestContent_GenesisValidator:
+		return "add-validator"
+	case *launchtypes.RequestContent_AccountRemoval:
+		return "remove-account"
+	case *launchtypes.RequestContent_ValidatorRemoval:
+		return "remove-validator"
+	}
+	return "unknown"
+}
+
+// formatChainParams returns the launch params from SPN.
+func formatChainParams(ctx context.Context, n network.Network, output string, retries uint64) (string, error) {
+	var params launchtypes.Params
+	err := retryQuery(ctx, retries, func() (err error) {
+		params, err = n.LaunchParams(ctx)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if output == outputJSON {
+		return formatJSON(params)
+	}
+	return yaml.Marshal(ctx, params)
+}
@@ -0,0 +1,1369 @@
+package starportcmd
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+	"github.com/tendermint/starport/starport/pkg/jsonpath"
+	"github.com/tendermint/starport/starport/services/network"
+	"github.com/tendermint/starport/starport/services/network/networkchain"
+	"github.com/tendermint/starport/starport/services/network/networktypes"
+	tmlog "github.com/tendermint/tendermint/libs/log"
+	"golang.org/x/text/language"
+)
+
+type chainLaunchFetcherMock struct {
+	launches map[uint64]networktypes.ChainLaunch
+	queried  []uint64
+}
+
+func (m *chainLaunchFetcherMock) ChainLaunch(_ context.Context, id uint64) (networktypes.ChainLaunch, error) {
+	m.queried = append(m.queried, id)
+	chainLaunch, ok := m.launches[id]
+	if !ok {
+		return networktypes.ChainLaunch{}, network.LaunchNotFoundError{LaunchID: id}
+	}
+	return chainLaunch, nil
+}
+
+func TestFetchChainLaunches(t *testing.T) {
+	launches := map[uint64]networktypes.ChainLaunch{
+		1: {ID: 1, ChainID: "foo-1"},
+		2: {ID: 2, ChainID: "bar-1"},
+	}
+
+	tests := []struct {
+		name        string
+		launchIDs   []uint64
+		want        []networktypes.ChainLaunch
+		wantQueried []uint64
+		wantErr     string
+	}{
+		{
+			name:        "existing launches",
+			launchIDs:   []uint64{1, 2},
+			want:        []networktypes.ChainLaunch{launches[1], launches[2]},
+			wantQueried: []uint64{1, 2},
+		},
+		{
+			name:        "launch not found",
+			launchIDs:   []uint64{999},
+			wantQueried: []uint64{999},
+			wantErr:     "launch id 999 not found on network",
+		},
+		{
+			name:        "stop at the first launch not found",
+			launchIDs:   []uint64{1, 999, 2},
+			wantQueried: []uint64{1, 999},
+			wantErr:     "launch id 999 not found on network",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &chainLaunchFetcherMock{launches: launches}
+			got, err := fetchChainLaunches(context.Background(), m, tt.launchIDs, 0)
+			require.Equal(t, tt.wantQueried, m.queried)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				var notFoundErr network.LaunchNotFoundError
+				require.True(t, errors.As(err, &notFoundErr))
+				require.Equal(t, exitCodeNotFound, ExitCode(err))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestIsChainIDArg(t *testing.T) {
+	tests := []struct {
+		arg  string
+		want bool
+	}{
+		{arg: "12", want: false},
+		{arg: "10-15", want: false},
+		{arg: "mychain-1", want: true},
+		{arg: "1a", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.arg, func(t *testing.T) {
+			require.Equal(t, tt.want, isChainIDArg(tt.arg))
+		})
+	}
+}
+
+func TestChangeAccountsPrefix(t *testing.T) {
+	chainAccounts := network.ChainAccounts{
+		Accounts: []networktypes.GenesisAccount{
+			{Address: "cosmos1dd246yq6z5vzjz9gh8cff46pll75yyl8ygndsj", Coins: "10stake"},
+			{Address: "cosmos17xpfvakm2amg962yls6f84z3kell8c5lserqta", Coins: "20stake"},
+			{Address: "invalid", Coins: "30stake"},
+		},
+		Vesting: map[string]networktypes.VestingAccount{
+			"cosmos1dd246yq6z5vzjz9gh8cff46pll75yyl8ygndsj": {
+				Address:      "cosmos1dd246yq6z5vzjz9gh8cff46pll75yyl8ygndsj",
+				TotalBalance: "10stake",
+			},
+		},
+	}
+
+	got, notes := changeAccountsPrefix(chainAccounts, "spn")
+	require.Equal(t, []networktypes.GenesisAccount{
+		{Address: "spn1dd246yq6z5vzjz9gh8cff46pll75yyl8c5tt7g", Coins: "10stake"},
+		{Address: "cosmos17xpfvakm2amg962yls6f84z3kell8c5lserqta", Coins: "20stake"},
+		{Address: "invalid", Coins: "30stake"},
+	}, got.Accounts)
+	require.Equal(t, map[string]networktypes.VestingAccount{
+		"spn1dd246yq6z5vzjz9gh8cff46pll75yyl8c5tt7g": {
+			Address:      "spn1dd246yq6z5vzjz9gh8cff46pll75yyl8c5tt7g",
+			TotalBalance: "10stake",
+		},
+	}, got.Vesting)
+	require.Len(t, notes, 2)
+	require.Equal(t, "cosmos17xpfvakm2amg962yls6f84z3kell8c5lserqta left untouched: module account", notes[0])
+}
+
+func TestExplainInfoFields(t *testing.T) {
+	info := network.ChainInfo{ChainID: "mars-1", CoordinatorID: 1}
+
+	got := explainInfoFields(info, nil)
+	require.True(t, strings.HasPrefix(got, "ChainID: chain ID of the chain initialized locally\nSourceURL:"))
+	require.NotContains(t, got, "CoordinatorAddress")
+
+	got = explainInfoFields(chainLiveInfo{ChainInfo: info}, []string{"latestheight", "SourceHash"})
+	require.Equal(t, "SourceHash: git commit the binary is built from\n"+
+		"LatestHeight: latest block height reported by the validators", got)
+}
+
+func TestFormatInfoJSONPath(t *testing.T) {
+	accounts := 2
+	info := network.ChainInfo{ChainID: "mars-1", CoordinatorID: 12345678901, AccountCount: &accounts}
+
+	tests := []struct {
+		name    string
+		summary interface{}
+		path    string
+		want    string
+		err     string
+	}{
+		{name: "string", summary: info, path: "$.ChainID", want: "mars-1"},
+		{name: "number", summary: info, path: "$.CoordinatorID", want: "12345678901"},
+		{name: "null", summary: chainLiveInfo{ChainInfo: info}, path: "$.LatestHeight", want: "null"},
+		{name: "no match", summary: info, path: "$.ValidatorCount", err: "no chain info value matches $.ValidatorCount"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path, err := jsonpath.Parse(tt.path)
+			require.NoError(t, err)
+			got, err := formatInfoJSONPath(tt.summary, path)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+type genesisAccountsStreamerMock []networktypes.GenesisAccount
+
+func (m genesisAccountsStreamerMock) StreamGenesisAccounts(
+	_ context.Context,
+	_ uint64,
+	fn func(networktypes.GenesisAccount) error,
+) error {
+	for _, acc := range m {
+		if err := fn(acc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestStreamChainAccounts(t *testing.T) {
+	streamer := genesisAccountsStreamerMock{
+		{Address: "spn1foo", Coins: "10stake"},
+		{Address: "spn1bar", Coins: "20token"},
+		{Address: "spn1baz", Coins: "30stake"},
+		{Address: "spn1qux", Coins: "40stake"},
+	}
+
+	tests := []struct {
+		name    string
+		output  string
+		options accountsOptions
+		want    string
+	}{
+		{
+			name: "all accounts",
+			want: "Genesis Account,Coins\nspn1foo,10stake\nspn1bar,20token\nspn1baz,30stake\nspn1qux,40stake\n",
+		},
+		{
+			name:    "denom with offset and limit",
+			options: accountsOptions{denom: "stake", offset: 1, limit: 1, tableOptions: tableOptions{noHeader: true}},
+			want:    "spn1baz,30stake\n",
+		},
+		{
+			name:    "ndjson",
+			output:  outputNDJSON,
+			options: accountsOptions{denom: "token"},
+			want:    `{"address":"spn1bar","coins":"20token"}` + "\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out strings.Builder
+			require.NoError(t, streamChainAccounts(context.Background(), &out, streamer, 1, tt.output, tt.options))
+			require.Equal(t, tt.want, out.String())
+		})
+	}
+}
+
+type genesisInformationFetcherMock networktypes.GenesisInformation
+
+func (m genesisInformationFetcherMock) GenesisInformation(context.Context, uint64) (networktypes.GenesisInformation, error) {
+	return networktypes.GenesisInformation(m), nil
+}
+
+type genesisInformationCounterMock struct {
+	gi      networktypes.GenesisInformation
+	queried int
+}
+
+func (m *genesisInformationCounterMock) GenesisInformation(context.Context, uint64) (networktypes.GenesisInformation, error) {
+	m.queried++
+	return m.gi, nil
+}
+
+func TestLaunchCache(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		dir      = t.TempDir()
+		start    = time.Now()
+		now      = start
+		launches = &chainLaunchFetcherMock{launches: map[uint64]networktypes.ChainLaunch{
+			1: {ID: 1, ChainID: "mars-1", LaunchTriggered: true},
+			2: {ID: 2, ChainID: "venus-1"},
+		}}
+		genesis = &genesisInformationCounterMock{gi: networktypes.GenesisInformation{
+			GenesisAccounts: []networktypes.GenesisAccount{{Address: "spn1", Coins: "10stake"}},
+		}}
+	)
+	newCache := func() *launchCache {
+		c := newLaunchCache(dir, time.Minute, launches, genesis)
+		c.now = func() time.Time { return now }
+		return c
+	}
+
+	// the entries are read from disk by a new cache within the TTL
+	for _, id := range []uint64{1, 2} {
+		_, err := newCache().ChainLaunch(ctx, id)
+		require.NoError(t, err)
+	}
+	now = now.Add(5 * time.Second)
+	for _, id := range []uint64{1, 2} {
+		gi, err := newCache().GenesisInformation(ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, genesis.gi, gi)
+	}
+	chainLaunch, err := newCache().ChainLaunch(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, "mars-1", chainLaunch.ChainID)
+	_, err = newCache().GenesisInformation(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2}, launches.queried)
+	require.Equal(t, 2, genesis.queried)
+
+	// the launch not triggered and its genesis information are only cached for maxUntriggeredCacheTTL
+	now = now.Add(maxUntriggeredCacheTTL)
+	for _, id := range []uint64{1, 2} {
+		_, err := newCache().ChainLaunch(ctx, id)
+		require.NoError(t, err)
+		_, err = newCache().GenesisInformation(ctx, id)
+		require.NoError(t, err)
+	}
+	require.Equal(t, []uint64{1, 2, 2}, launches.queried)
+	require.Equal(t, 3, genesis.queried)
+
+	// the launch is queried again once the TTL expires, the genesis information
+	// cached since is dropped when the launch changed
+	now = start.Add(time.Minute)
+	_, err = newCache().GenesisInformation(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, 3, genesis.queried)
+	launches.launches[1] = networktypes.ChainLaunch{ID: 1, ChainID: "mars-1"}
+	_, err = newCache().ChainLaunch(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2, 2, 1}, launches.queried)
+	_, err = newCache().GenesisInformation(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, 4, genesis.queried)
+
+	// the launches that don't exist aren't cached
+	_, err = newCache().ChainLaunch(ctx, 3)
+	require.Error(t, err)
+	require.NoFileExists(t, filepath.Join(dir, "3.json"))
+}
+
+func TestCheckNonEmpty(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		empty   = genesisInformationFetcherMock{}
+		vesting = genesisInformationFetcherMock{
+			VestingAccounts: []networktypes.VestingAccount{{Address: "spn1foo"}},
+		}
+		validators = genesisInformationFetcherMock{
+			GenesisValidators: []networktypes.GenesisValidator{{Address: "spn1foo"}},
+		}
+	)
+
+	err := checkNonEmpty(ctx, empty, 1, chainShowAccounts, false)
+	require.EqualError(t, err, "launch 1 has no genesis accounts")
+	require.Equal(t, exitCodeEmpty, ExitCode(err))
+
+	require.Error(t, checkNonEmpty(ctx, vesting, 1, chainShowAccounts, false))
+	require.NoError(t, checkNonEmpty(ctx, vesting, 1, chainShowAccounts, true))
+
+	require.EqualError(t, checkNonEmpty(ctx, empty, 1, chainShowValidators, false), "launch 1 has no genesis validators")
+	require.NoError(t, checkNonEmpty(ctx, validators, 1, chainShowValidators, false))
+}
+
+func TestCacheLaunchRecords(t *testing.T) {
+	chainLaunches := []networktypes.ChainLaunch{{ID: 1, ChainID: "mars-1"}}
+
+	home := t.TempDir()
+	cacheLaunchRecords(home, chainLaunches, tmlog.NewNopLogger())
+	got, err := networkchain.LoadLaunchRecord(home)
+	require.NoError(t, err)
+	require.Equal(t, chainLaunches[0], got)
+
+	// the homes of the chains not initialized locally are never created
+	missing := filepath.Join(t.TempDir(), "missing")
+	cacheLaunchRecords(missing, chainLaunches, tmlog.NewNopLogger())
+	_, err = os.Stat(missing)
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestParseInfoTemplate(t *testing.T) {
+	tmpl, err := parseInfoTemplate("{{.ChainID}} @ {{.SourceURL}}", false)
+	require.NoError(t, err)
+	var out strings.Builder
+	require.NoError(t, tmpl.Execute(&out, network.ChainInfo{ChainID: "mars-1", SourceURL: "https://github.com/foo/mars"}))
+	require.Equal(t, "mars-1 @ https://github.com/foo/mars", out.String())
+
+	_, err = parseInfoTemplate("{{.ChainID", false)
+	require.Error(t, err)
+
+	_, err = parseInfoTemplate("{{.Foo}}", false)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "can't evaluate field Foo")
+
+	// the runtime fields are only available with --live
+	_, err = parseInfoTemplate("{{.LatestHeight}}", false)
+	require.Error(t, err)
+	_, err = parseInfoTemplate("{{.LatestHeight}}", true)
+	require.NoError(t, err)
+}
+
+func TestResolveGenesisPath(t *testing.T) {
+	dir := t.TempDir()
+	home := filepath.Join(dir, "home")
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "config"), 0755))
+	genesisPath := filepath.Join(home, "config", "genesis.json")
+	require.NoError(t, os.WriteFile(genesisPath, []byte("{}"), 0644))
+
+	// the real directory may be behind a symlink itself, e.g. on macOS
+	want, err := filepath.EvalSymlinks(genesisPath)
+	require.NoError(t, err)
+
+	got, err := resolveGenesisPath(genesisPath)
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+
+	link := filepath.Join(dir, "link")
+	require.NoError(t, os.Symlink(home, link))
+	got, err = resolveGenesisPath(filepath.Join(link, "config", "genesis.json"))
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+
+	_, err = resolveGenesisPath(filepath.Join(dir, "missing", "config", "genesis.json"))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "the chain is not initialized")
+}
+
+func TestAccountColumns(t *testing.T) {
+	require.NoError(t, validateAccountColumns([]string{"address", " Coins", "vesting"}))
+	require.EqualError(t,
+		validateAccountColumns([]string{"address", "foo"}),
+		"invalid column foo, use one of: address, coins, mine, vesting, vesting-end",
+	)
+
+	var (
+		acc             = networktypes.GenesisAccount{Address: "spn1foo", Coins: "10stake"}
+		vestingAccounts = map[string]networktypes.VestingAccount{
+			"spn1foo": {Address: "spn1foo", EndTime: 0},
+		}
+	)
+
+	options := accountsOptions{columns: []string{"address", "coins", "vesting"}}
+	require.Equal(t, []string{"Address", "Coins", "Vesting"}, options.header())
+	require.Equal(t, []string{"spn1foo", "10stake", vestingTypeDelayed}, options.entry(acc, vestingAccounts))
+
+	// the default columns
+	options = accountsOptions{}
+	require.Equal(t, chainAccSummaryHeader, options.header())
+	require.Equal(t, []string{"spn1foo", "10stake"}, options.entry(acc, vestingAccounts))
+}
+
+func TestFormatDuplicateAccounts(t *testing.T) {
+	ctx := context.Background()
+
+	summary, err := formatDuplicateAccounts(ctx, []networktypes.GenesisAccount{}, outputText, tableOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "no duplicated genesis account", summary)
+
+	duplicates := []networktypes.GenesisAccount{
+		{Address: "spn1foo", Coins: "10stake"},
+		{Address: "spn1foo", Coins: "50stake"},
+	}
+	summary, err = formatDuplicateAccounts(ctx, duplicates, outputText, tableOptions{noHeader: true})
+	require.EqualError(t, err, "1 addresses are used by several genesis accounts")
+	require.Contains(t, summary, "10stake")
+	require.Contains(t, summary, "50stake")
+}
+
+func TestPrepareExportDir(t *testing.T) {
+	t.Run("missing dir is created", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "launch", "export")
+		require.NoError(t, prepareExportDir(dir, false))
+		require.DirExists(t, dir)
+	})
+	t.Run("empty dir", func(t *testing.T) {
+		require.NoError(t, prepareExportDir(t.TempDir(), false))
+	})
+	t.Run("non-empty dir", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "info.yaml"), nil, 0644))
+		err := prepareExportDir(dir, false)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "is not empty")
+	})
+	t.Run("non-empty dir with force", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "info.yaml"), nil, 0644))
+		require.NoError(t, prepareExportDir(dir, true))
+	})
+}
+
+func TestHumanizeCoins(t *testing.T) {
+	displays := map[string]denomDisplay{
+		"ustake": {denom: "stake", exponent: 6},
+	}
+
+	tests := []struct {
+		name       string
+		coins      string
+		si         bool
+		separators *numberSeparators
+		want       string
+	}{
+		{name: "separators", coins: "1000000000000token", want: "1,000,000,000,000 token"},
+		{name: "small amount", coins: "999token", want: "999 token"},
+		{name: "display denom", coins: "1234500000ustake", want: "1,234.5 stake"},
+		{name: "display denom below one", coins: "5ustake", want: "0.000005 stake"},
+		{name: "si suffix", coins: "1000000000000token", si: true, want: "1.0T token"},
+		{name: "si suffix in display denom", coins: "2500000000ustake", si: true, want: "2.5k stake"},
+		{name: "si below a thousand", coins: "999token", si: true, want: "999 token"},
+		{name: "several coins", coins: "1000000ustake,1000token", want: "1,000 token, 1 stake"},
+		{name: "invalid coins", coins: "invalid coins", want: "invalid coins"},
+		{
+			name:       "locale separators",
+			coins:      "1234567500000ustake",
+			separators: &numberSeparators{group: ".", decimal: ","},
+			want:       "1.234.567,5 stake",
+		},
+		{
+			name:       "locale decimal separator with si suffix",
+			coins:      "2500000000ustake",
+			si:         true,
+			separators: &numberSeparators{group: ".", decimal: ","},
+			want:       "2,5k stake",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			separators := defaultSeparators
+			if tt.separators != nil {
+				separators = *tt.separators
+			}
+			require.Equal(t, tt.want, humanizeCoins(tt.coins, displays, tt.si, separators))
+		})
+	}
+}
+
+func TestLocaleSeparators(t *testing.T) {
+	tests := []struct {
+		locale string
+		want   numberSeparators
+	}{
+		{locale: "en", want: numberSeparators{group: ",", decimal: "."}},
+		{locale: "de", want: numberSeparators{group: ".", decimal: ","}},
+		{locale: "fr", want: numberSeparators{group: "\u00a0", decimal: ","}},
+		{locale: "de-CH", want: numberSeparators{group: "’", decimal: "."}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.locale, func(t *testing.T) {
+			require.Equal(t, tt.want, localeSeparators(language.MustParse(tt.locale)))
+		})
+	}
+}
+
+func TestGenesisDenomDisplays(t *testing.T) {
+	home := t.TempDir()
+	require.Empty(t, genesisDenomDisplays(home))
+
+	genesis := `{"app_state":{"bank":{"denom_metadata":[{
+		"base": "ustake",
+		"display": "stake",
+		"denom_units": [{"denom": "ustake", "exponent": 0}, {"denom": "stake", "exponent": 6}]
+	}]}}}`
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "config"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(home, "config", "genesis.json"), []byte(genesis), 0644))
+	require.Equal(t, map[string]denomDisplay{
+		"ustake": {denom: "stake", exponent: 6},
+	}, genesisDenomDisplays(home))
+}
+
+func TestWrapJSON(t *testing.T) {
+	fetchedAt := time.Date(2021, 12, 10, 12, 0, 0, 0, time.UTC)
+
+	t.Run("single launch", func(t *testing.T) {
+		wrapped, err := wrapJSON(`[{"address":"spn1a"}]`, chainShowAccounts, []uint64{42}, fetchedAt)
+		require.NoError(t, err)
+		require.JSONEq(t, `{
+			"schema_version": 1,
+			"launch_id": 42,
+			"type": "accounts",
+			"data": [{"address": "spn1a"}],
+			"fetched_at": "2021-12-10T12:00:00Z"
+		}`, wrapped)
+	})
+	t.Run("launch range", func(t *testing.T) {
+		wrapped, err := wrapJSON(`[{},{}]`, chainShowInfo, []uint64{1, 2}, fetchedAt)
+		require.NoError(t, err)
+		require.JSONEq(t, `{
+			"schema_version": 1,
+			"launch_id": 1,
+			"launch_ids": [1, 2],
+			"type": "info",
+			"data": [{}, {}],
+			"fetched_at": "2021-12-10T12:00:00Z"
+		}`, wrapped)
+	})
+	t.Run("status message", func(t *testing.T) {
+		wrapped, err := wrapJSON("✔ Genesis written: genesis.json", chainShowGenesis, []uint64{42}, fetchedAt)
+		require.NoError(t, err)
+		require.Equal(t, "✔ Genesis written: genesis.json", wrapped)
+	})
+}
+
+func TestAccountsOptionsMatches(t *testing.T) {
+	tests := []struct {
+		name    string
+		options accountsOptions
+		acc     networktypes.GenesisAccount
+		want    bool
+	}{
+		{
+			name:    "matching address",
+			options: accountsOptions{addressMatch: regexp.MustCompile("^spn1a")},
+			acc:     networktypes.GenesisAccount{Address: "spn1abc", Coins: "10stake"},
+			want:    true,
+		},
+		{
+			name:    "other address",
+			options: accountsOptions{addressMatch: regexp.MustCompile("^spn1a")},
+			acc:     networktypes.GenesisAccount{Address: "spn1bcd", Coins: "10stake"},
+			want:    false,
+		},
+		{
+			name:    "matching address and denom",
+			options: accountsOptions{addressMatch: regexp.MustCompile("^spn1a"), denom: "stake"},
+			acc:     networktypes.GenesisAccount{Address: "spn1abc", Coins: "10stake"},
+			want:    true,
+		},
+		{
+			name:    "matching address without the denom",
+			options: accountsOptions{addressMatch: regexp.MustCompile("^spn1a"), denom: "token"},
+			acc:     networktypes.GenesisAccount{Address: "spn1abc", Coins: "10stake"},
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.options.matches(tt.acc)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAddressMatchWithPrefix(t *testing.T) {
+	accounts := []networktypes.GenesisAccount{
+		{Address: "spn1dd246yq6z5vzjz9gh8cff46pll75yyl8c5tt7g", Coins: "10stake"},
+		{Address: "spn1mmlqwyqk7neqegffp99q86eckpm4pjahgp2yjp", Coins: "20stake"},
+	}
+	options := accountsOptions{addressMatch: regexp.MustCompile("^cosmos1dd"), bech32Prefix: "cosmos"}
+	want := `{"address":"cosmos1dd246yq6z5vzjz9gh8cff46pll75yyl8ygndsj","coins":"10stake"}`
+
+	// the streamed and the fetched accounts are matched with their new prefix
+	var streamed strings.Builder
+	err := streamChainAccounts(context.Background(), &streamed, genesisAccountsStreamerMock(accounts), 1, outputNDJSON, options)
+	require.NoError(t, err)
+	require.Equal(t, want+"\n", streamed.String())
+
+	fetched, err := formatChainAccounts(context.Background(), genesisInformationFetcherMock{GenesisAccounts: accounts}, 1, outputNDJSON, options)
+	require.NoError(t, err)
+	require.Equal(t, want, strings.TrimSpace(fetched))
+}
+
+func TestMergePeers(t *testing.T) {
+	current := []string{"a@1.1.1.1:26656", "b@2.2.2.2:26656"}
+	peers := []string{"b@3.3.3.3:26656", "c@4.4.4.4:26656", "c@4.4.4.4:26656"}
+
+	require.Equal(t, []string{
+		"a@1.1.1.1:26656",
+		"b@2.2.2.2:26656",
+		"c@4.4.4.4:26656",
+	}, mergePeers(current, peers))
+}
+
+func TestMergeConfigPeers(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.toml")
+	config := `# node config
+moniker = "node"
+
+[p2p]
+# comma separated list of nodes to keep persistent connections to
+persistent_peers = "a@1.1.1.1:26656, b@2.2.2.2:26656"
+
+[statesync]
+persistent_peers = "untouched"
+`
+	require.NoError(t, os.WriteFile(configPath, []byte(config), 0600))
+	peers := []string{"b@3.3.3.3:26656", "c@4.4.4.4:26656"}
+
+	line, err := mergeConfigPeers(configPath, peers, false)
+	require.NoError(t, err)
+	require.Equal(t, `persistent_peers = "a@1.1.1.1:26656,b@2.2.2.2:26656,c@4.4.4.4:26656"`, line)
+
+	_, err = mergeConfigPeers(configPath, peers, true)
+	require.NoError(t, err)
+	written, err := os.ReadFile(configPath)
+	require.NoError(t, err)
+	require.Equal(t, strings.Replace(config, `"a@1.1.1.1:26656, b@2.2.2.2:26656"`, `"a@1.1.1.1:26656,b@2.2.2.2:26656,c@4.4.4.4:26656"`, 1), string(written))
+	stat, err := os.Stat(configPath)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), stat.Mode())
+}
+
+func TestReplaceConfigPeers(t *testing.T) {
+	line := `persistent_peers = "a@1.1.1.1:26656"`
+
+	tests := []struct {
+		name   string
+		config string
+		want   string
+	}{
+		{
+			name:   "indented line",
+			config: "[p2p]\n  persistent_peers = \"\"\n  seeds = \"\"\n",
+			want:   "[p2p]\n  " + line + "\n  seeds = \"\"\n",
+		},
+		{
+			name:   "no persistent peers",
+			config: "[p2p]\nseeds = \"\"\n",
+			want:   "[p2p]\n" + line + "\nseeds = \"\"\n",
+		},
+		{
+			name:   "no p2p table",
+			config: "moniker = \"node\"\n",
+			want:   "moniker = \"node\"\n\n[p2p]\n" + line + "\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, string(replaceConfigPeers([]byte(tt.config), line)))
+		})
+	}
+}
+
+func TestGenesisChecksum(t *testing.T) {
+	checksum, err := genesisChecksum(strings.NewReader(`{"chain_id":"mychain-1"}`))
+	require.NoError(t, err)
+	require.Equal(t, "sha256:57ebd9b758862aa48fab67d0848723f9925ddca7402ae7f7dd40b39ab8c12c24", checksum)
+}
+
+func TestFetchEachChainLaunch(t *testing.T) {
+	launches := map[uint64]networktypes.ChainLaunch{
+		1: {ID: 1, ChainID: "foo-1"},
+		2: {ID: 2, ChainID: "bar-1"},
+	}
+	m := &chainLaunchFetcherMock{launches: launches}
+
+	got, failures := fetchEachChainLaunch(context.Background(), m, []uint64{1, 999, 2}, 0)
+	require.Equal(t, []uint64{1, 999, 2}, m.queried)
+	require.Equal(t, []networktypes.ChainLaunch{launches[1], launches[2]}, got)
+	require.Len(t, failures, 1)
+	require.Equal(t, uint64(999), failures[0].launchID)
+	require.Equal(t, "1 launches failed:\n  999: launch id 999 not found on network", failures.Error())
+}
+
+func TestJoinLaunchErrors(t *testing.T) {
+	fetchFailures := launchErrors{{launchID: 5, err: errors.New("not found")}}
+
+	t.Run("no failures", func(t *testing.T) {
+		require.NoError(t, joinLaunchErrors(nil, nil))
+	})
+	t.Run("fetch and format failures", func(t *testing.T) {
+		err := joinLaunchErrors(fetchFailures, launchErrors{{launchID: 2, err: errors.New("invalid source")}})
+		var failures launchErrors
+		require.True(t, errors.As(err, &failures))
+		require.Equal(t, []uint64{2, 5}, []uint64{failures[0].launchID, failures[1].launchID})
+	})
+	t.Run("other error", func(t *testing.T) {
+		err := errors.New("connection refused")
+		require.Equal(t, err, joinLaunchErrors(fetchFailures, err))
+	})
+}
+
+func TestFormatChainRequests(t *testing.T) {
+	requests := []launchtypes.Request{
+		{
+			RequestID: 1,
+			Creator:   "spn1a",
+			Content:   launchtypes.NewGenesisAccount(1, "spn1a", sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(10)))),
+		},
+		{
+			RequestID: 2,
+			Creator:   "spn1b",
+			Content:   launchtypes.NewValidatorRemoval("spn1c"),
+		},
+	}
+
+	t.Run("table", func(t *testing.T) {
+		summary, err := formatChainRequests(requests, outputText, tableOptions{noHeader: true})
+		require.NoError(t, err)
+		lines := strings.Split(strings.TrimSpace(summary), "\n")
+		require.Len(t, lines, 2)
+		require.Equal(t, []string{"1", "add-account", "spn1a", "pending"}, strings.Fields(lines[0]))
+		require.Equal(t, []string{"2", "remove-validator", "spn1b", "pending"}, strings.Fields(lines[1]))
+	})
+	t.Run("json", func(t *testing.T) {
+		summary, err := formatChainRequests(requests, outputJSON, tableOptions{})
+		require.NoError(t, err)
+		require.JSONEq(t, `[
+			{"requestID": 1, "type": "add-account", "creator": "spn1a", "status": "pending"},
+			{"requestID": 2, "type": "remove-validator", "creator": "spn1b", "status": "pending"}
+		]`, summary)
+	})
+}
+
+func TestFormatNDJSON(t *testing.T) {
+	got, err := formatNDJSON([]networktypes.GenesisAccount{
+		{Address: "spn1foo", Coins: "10stake"},
+		{Address: "spn1bar", Coins: "20token"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, `{"address":"spn1foo","coins":"10stake"}`+"\n"+`{"address":"spn1bar","coins":"20token"}`, got)
+
+	got, err = formatNDJSON([]string{})
+	require.NoError(t, err)
+	require.Empty(t, got)
+
+	got, err = formatNDJSON(map[string]int{"stake": 1})
+	require.NoError(t, err)
+	require.Equal(t, `{"stake":1}`, got)
+}
+
+func TestVerifyPeerKeys(t *testing.T) {
+	gentx := func(memo string) []byte {
+		return []byte(fmt.Sprintf(`{"body":{"memo":%q,"messages":[{"value":{"denom":"stake","amount":"1"}}]}}`, memo))
+	}
+	validators := []networktypes.GenesisValidator{
+		{Address: "spn1foo", Peer: "9B1F4ADB@192.168.0.1:26656", Gentx: gentx("9b1f4adb@192.168.0.148:26656")},
+		{Address: "spn1bar", Peer: "a412c917@192.168.0.2:26656", Gentx: gentx("9b1f4adb@192.168.0.148:26656")},
+		{Address: "spn1baz", Peer: "c0ffee@192.168.0.3:26656", Gentx: gentx("")},
+		{Address: "spn1qux", Peer: "c0ffee@192.168.0.4:26656", Gentx: []byte("invalid")},
+	}
+
+	require.Equal(t, []peerKeySummary{
+		{Address: "spn1foo", PeerNodeID: "9B1F4ADB", GentxNodeID: "9b1f4adb", Status: peerKeyMatch},
+		{Address: "spn1bar", PeerNodeID: "a412c917", GentxNodeID: "9b1f4adb", Status: peerKeyMismatch},
+		{Address: "spn1baz", PeerNodeID: "c0ffee", Status: peerKeyUnknown},
+		{Address: "spn1qux", PeerNodeID: "c0ffee", Status: peerKeyUnknown},
+	}, verifyPeerKeys(validators))
+}
+
+type launchTriggerMock struct {
+	queries, triggerAt int
+}
+
+func (m *launchTriggerMock) ChainLaunch(_ context.Context, id uint64) (networktypes.ChainLaunch, error) {
+	m.queries++
+	return networktypes.ChainLaunch{ID: id, LaunchTriggered: m.triggerAt > 0 && m.queries >= m.triggerAt}, nil
+}
+
+func TestSPNQuery(t *testing.T) {
+	err := spnQuery(context.Background(), 10*time.Millisecond, func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	require.EqualError(t, err, "operation timed out after 10ms")
+
+	// the context given to the query isn't bounded without a timeout
+	err = spnQuery(context.Background(), 0, func(ctx context.Context) error {
+		_, ok := ctx.Deadline()
+		require.False(t, ok)
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestWaitForLaunch(t *testing.T) {
+	m := &launchTriggerMock{triggerAt: 3}
+	got, err := waitForLaunch(context.Background(), m, 1, time.Millisecond, 0)
+	require.NoError(t, err)
+	require.True(t, got.LaunchTriggered)
+	require.Equal(t, 3, m.queries)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = waitForLaunch(ctx, &launchTriggerMock{}, 1, time.Millisecond, 0)
+	require.True(t, errors.Is(err, context.DeadlineExceeded))
+}
+
+func TestConfirmGenesisDownloadWithoutSpinner(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Length", strconv.Itoa(genesisConfirmSize+1))
+	}))
+	defer server.Close()
+
+	var nb NetworkBuilder
+	WithoutSpinner()(&nb)
+
+	// the size is above the confirmation size but the download is already confirmed
+	var errOut bytes.Buffer
+	err := confirmGenesisDownload(context.Background(), nb, server.Client(), server.URL+"/genesis", true, &errOut)
+	require.NoError(t, err)
+	require.Equal(t, "expected genesis size: 100 MB\n", errOut.String())
+}
+
+func TestPollLaunchWithoutSpinner(t *testing.T) {
+	var nb NetworkBuilder
+	WithoutSpinner()(&nb)
+
+	got, err := pollLaunch(context.Background(), nb, &launchTriggerMock{triggerAt: 2}, 1, time.Millisecond, time.Second, 0)
+	require.NoError(t, err)
+	require.True(t, got.LaunchTriggered)
+
+	_, err = pollLaunch(context.Background(), nb, &launchTriggerMock{}, 1, time.Millisecond, 20*time.Millisecond, 0)
+	require.EqualError(t, err, "launch 1 not triggered after 20ms")
+}
+
+func TestGenesisContentLength(t *testing.T) {
+	genesis := []byte(`{"chain_id":"mars-1"}`)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/nohead" && r.Method == http.MethodHead {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(genesis)))
+		w.Write(genesis)
+	}))
+	defer server.Close()
+
+	size, err := genesisContentLength(context.Background(), server.Client(), server.URL+"/genesis.json")
+	require.NoError(t, err)
+	require.Equal(t, int64(len(genesis)), size)
+
+	size, err = genesisContentLength(context.Background(), server.Client(), server.URL+"/nohead")
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), size)
+
+	_, err = genesisContentLength(context.Background(), server.Client(), "file:///genesis.json")
+	require.Error(t, err)
+}
+
+func TestNetworkChainShowOutput(t *testing.T) {
+	home := t.TempDir()
+	require.NoError(t, networkchain.SaveLaunchRecord(home, networktypes.ChainLaunch{ID: 1, ChainID: "mars-1"}))
+
+	var out strings.Builder
+	c := NewNetworkChainShow()
+	c.SetOut(&out)
+	c.SetArgs([]string{"info", "1", "--offline", "--home", home})
+	require.NoError(t, c.Execute())
+	require.True(t, strings.HasPrefix(out.String(), "chainid: mars-1\n"))
+}
+
+func TestGroupAccounts(t *testing.T) {
+	accounts := []networktypes.GenesisAccount{
+		{Address: "spn1foo", Coins: "500stake,2000token"},
+		{Address: "spn1bar", Coins: "1500stake"},
+		{Address: "spn1baz", Coins: "999stake"},
+	}
+
+	got, err := groupAccounts(accounts, groupByDenom)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	require.Equal(t, []string{"stake", "3", "2999"}, []string{got[0].Denom, strconv.Itoa(got[0].Accounts), got[0].Total})
+	require.Equal(t, []string{"token", "1", "2000"}, []string{got[1].Denom, strconv.Itoa(got[1].Accounts), got[1].Total})
+
+	got, err = groupAccounts(accounts, groupByHolderClass)
+	require.NoError(t, err)
+	require.Len(t, got, 3)
+	require.Equal(t, []string{"stake", "1-999", "2", "1499"}, []string{got[0].Denom, got[0].Class, strconv.Itoa(got[0].Accounts), got[0].Total})
+	require.Equal(t, []string{"stake", "1k-999k", "1", "1500"}, []string{got[1].Denom, got[1].Class, strconv.Itoa(got[1].Accounts), got[1].Total})
+	require.Equal(t, "token", got[2].Denom)
+
+	_, err = groupAccounts([]networktypes.GenesisAccount{{Address: "spn1foo", Coins: "invalid!"}}, groupByDenom)
+	require.Error(t, err)
+}
+
+func TestHolderClass(t *testing.T) {
+	tests := []struct {
+		amount int64
+		want   string
+	}{
+		{1, "1-999"},
+		{999, "1-999"},
+		{1000, "1k-999k"},
+		{25000000, "1M-999M"},
+		{1000000000000000000, "1E+"},
+	}
+	for _, tt := range tests {
+		_, got := holderClass(sdk.NewInt(tt.amount))
+		require.Equal(t, tt.want, got)
+	}
+	_, got := holderClass(sdk.NewIntWithDecimal(1, 24))
+	require.Equal(t, "1E+", got)
+}
+
+type genesisInformationErrorMock struct {
+	gi  networktypes.GenesisInformation
+	err error
+}
+
+func (m genesisInformationErrorMock) GenesisInformation(context.Context, uint64) (networktypes.GenesisInformation, error) {
+	return m.gi, m.err
+}
+
+func TestPartialGenesisFetcher(t *testing.T) {
+	gi := networktypes.GenesisInformation{
+		GenesisAccounts: []networktypes.GenesisAccount{{Address: "spn1foo", Coins: "10stake"}},
+	}
+	partialErr := network.GenesisInformationError{{Section: "genesis validators", Err: errors.New("unavailable")}}
+
+	var errOut bytes.Buffer
+	got, err := partialGenesisFetcher{
+		fetcher: genesisInformationErrorMock{gi: gi, err: partialErr},
+		errOut:  &errOut,
+	}.GenesisInformation(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, gi, got)
+	require.Equal(t, "warning: the genesis validators are missing from the summary: unavailable\n", errOut.String())
+
+	_, err = partialGenesisFetcher{fetcher: genesisInformationErrorMock{err: errors.New("canceled")}}.GenesisInformation(context.Background(), 1)
+	require.EqualError(t, err, "canceled")
+}
+
+func TestFormatChainValidatorsMine(t *testing.T) {
+	gi := genesisInformationFetcherMock{
+		GenesisValidators: []networktypes.GenesisValidator{
+			{Address: "spn1dd246yq6z5vzjz9gh8cff46pll75yyl8c5tt7g", SelfDelegation: "10stake", Peer: "foo@1.2.3.4:26656"},
+			{Address: "spn1mmlqwyqk7neqegffp99q86eckpm4pjahgp2yjp", SelfDelegation: "20stake", Peer: "bar@1.2.3.5:26656"},
+		},
+	}
+	mine := map[string]struct{}{"spn1dd246yq6z5vzjz9gh8cff46pll75yyl8c5tt7g": {}}
+
+	got, err := formatChainValidators(context.Background(), gi, 1, outputNDJSON, validatorsOptions{mine: mine})
+	require.NoError(t, err)
+	lines := strings.Split(got, "\n")
+	require.Len(t, lines, 2)
+	require.True(t, strings.HasSuffix(lines[0], `"mine":true}`))
+	require.True(t, strings.HasSuffix(lines[1], `"mine":false}`))
+
+	got, err = formatChainValidators(context.Background(), gi, 1, outputText, validatorsOptions{mine: mine})
+	require.NoError(t, err)
+	require.Regexp(t, `(?m)^spn1dd246yq6z5vzjz9gh8cff46pll75yyl8c5tt7g\s.*\strue\s*$`, got)
+	require.Regexp(t, `(?m)^spn1mmlqwyqk7neqegffp99q86eckpm4pjahgp2yjp\s.*\sfalse\s*$`, got)
+
+	got, err = formatChainValidators(context.Background(), gi, 1, outputJSON, validatorsOptions{})
+	require.NoError(t, err)
+	require.NotContains(t, got, "mine")
+}
+
+func TestFormatChainValidatorsWidth(t *testing.T) {
+	gi := genesisInformationFetcherMock{
+		GenesisValidators: []networktypes.GenesisValidator{
+			{Address: "spn1dd246yq6z5vzjz9gh8cff46pll75yyl8c5tt7g", SelfDelegation: "10stake", Peer: "foo@1.2.3.4:26656"},
+		},
+	}
+
+	got, err := formatChainValidators(context.Background(), gi, 1, outputText, validatorsOptions{
+		tableOptions: tableOptions{noHeader: true, maxColumnWidth: 16},
+	})
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(got, "spn1dd2...c5tt7g "))
+
+	got, err = formatChainValidators(context.Background(), gi, 1, outputText, validatorsOptions{
+		tableOptions: tableOptions{noHeader: true},
+	})
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(got, "spn1dd246yq6z5vzjz9gh8cff46pll75yyl8c5tt7g "))
+}
+
+func TestFormatChainValidatorsPower(t *testing.T) {
+	gi := genesisInformationFetcherMock{
+		GenesisValidators: []networktypes.GenesisValidator{
+			{Address: "spn1foo", SelfDelegation: "25stake", Peer: "foo@1.2.3.4:26656"},
+			{Address: "spn1bar", SelfDelegation: "75stake", Peer: "bar@1.2.3.5:26656"},
+		},
+	}
+
+	got, err := formatChainValidators(context.Background(), gi, 1, outputText, validatorsOptions{power: true})
+	require.NoError(t, err)
+	require.Regexp(t, `(?m)^spn1foo\s.*\s25\.00%\s*$`, got)
+	require.Regexp(t, `(?m)^spn1bar\s.*\s75\.00%\s*$`, got)
+	require.True(t, strings.HasSuffix(got, "\n1 of 2 validators hold more than a third of the voting power"))
+
+	got, err = formatChainValidators(context.Background(), gi, 1, outputNDJSON, validatorsOptions{power: true})
+	require.NoError(t, err)
+	require.Contains(t, got, `"power":0.75`)
+}
+
+func TestWriteGenesisGzip(t *testing.T) {
+	genesis := []byte(`{"chain_id":"mars-1"}`)
+	out := filepath.Join(t.TempDir(), "genesis.json.gz")
+	require.NoError(t, writeGenesis(bytes.NewReader(genesis), out, false, true))
+
+	compressed, err := os.ReadFile(out)
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(compressed, gzipMagic))
+
+	got, err := decompressGenesis(compressed)
+	require.NoError(t, err)
+	require.Equal(t, genesis, got)
+}
+
+func TestWriteGenesisFailure(t *testing.T) {
+	dir := t.TempDir()
+	out := filepath.Join(dir, "genesis.json")
+	require.NoError(t, os.WriteFile(out, []byte(`{"chain_id":"mars-1"}`), 0644))
+
+	src := io.MultiReader(strings.NewReader(`{"chain_id":`), iotest.ErrReader(errors.New("connection reset")))
+	require.EqualError(t, writeGenesis(src, out, true, false), "connection reset")
+
+	got, err := os.ReadFile(out)
+	require.NoError(t, err)
+	require.Equal(t, `{"chain_id":"mars-1"}`, string(got))
+
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+}
+
+func TestDecompressGenesis(t *testing.T) {
+	genesis := []byte(`{"chain_id":"mars-1"}`)
+	got, err := decompressGenesis(genesis)
+	require.NoError(t, err)
+	require.Equal(t, genesis, got)
+
+	_, err = decompressGenesis(append(append([]byte{}, gzipMagic...), "invalid"...))
+	require.Error(t, err)
+}
+
+func TestDecompressGenesisReader(t *testing.T) {
+	genesis := []byte(`{"chain_id":"mars-1"}`)
+	var compressed bytes.Buffer
+	w := gzip.NewWriter(&compressed)
+	_, err := w.Write(genesis)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	for _, src := range [][]byte{genesis, compressed.Bytes()} {
+		r, err := decompressGenesisReader(bytes.NewReader(src))
+		require.NoError(t, err)
+		got, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, genesis, got)
+	}
+
+	_, err = decompressGenesisReader(bytes.NewReader(append(append([]byte{}, gzipMagic...), "invalid"...)))
+	require.Error(t, err)
+}
+
+func TestIdleTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the first bytes are sent before the transfer stalls
+		w.Write([]byte(`{"chain_id"`))
+		w.(http.Flusher).Flush()
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	idle := newIdleTimeout(context.Background(), 50*time.Millisecond)
+	defer idle.Stop()
+
+	req, err := http.NewRequestWithContext(idle.ctx, http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	resp, err := server.Client().Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	_, err = io.ReadAll(idle.Reader(resp.Body))
+	require.EqualError(t, idle.Err(err), "no data received for 50ms")
+}
+
+func TestFetchRPCGenesis(t *testing.T) {
+	genesis := fmt.Sprintf(`{"chain_id":"mars-1","memo":%q}`, strings.Repeat("a", 1500))
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"result":{"genesis":%s}}`, genesis)
+	}))
+	defer server.Close()
+
+	// the rate limited download lasts longer than the first second of bytes
+	got, err := fetchRPCGenesis(context.Background(), rateLimitedClient(1000), server.URL)
+	require.NoError(t, err)
+	require.JSONEq(t, genesis, string(got))
+}
+
+func TestFormatDownloadedGenesis(t *testing.T) {
+	genesis := []byte(`{"chain_id":"mars-1","genesis_time":"2021-01-01T00:00:00Z","app_state":{}}`)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.ServeContent(w, r, "genesis.json", time.Time{}, bytes.NewReader(genesis))
+	}))
+	defer server.Close()
+
+	out := filepath.Join(t.TempDir(), "genesis.json")
+	summary, err := formatDownloadedGenesis(context.Background(), server.Client(), server.URL, nil, genesisOptions{
+		out:      out,
+		validate: true,
+	})
+	require.NoError(t, err)
+	require.Contains(t, summary, out)
+
+	got, err := os.ReadFile(out)
+	require.NoError(t, err)
+	require.Equal(t, genesis, got)
+
+	// the part file and its validator are removed once the genesis is written
+	_, err = os.Stat(out + partExt)
+	require.True(t, os.IsNotExist(err))
+	_, err = os.Stat(out + partExt + validatorExt)
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestDownloadGenesis(t *testing.T) {
+	genesis := []byte(`{"chain_id":"mars-1","app_state":{}}`)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/norange" {
+			w.Write(genesis)
+			return
+		}
+		w.Header().Set("ETag", `"v2"`)
+		http.ServeContent(w, r, "genesis.json", time.Time{}, bytes.NewReader(genesis))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name      string
+		path      string
+		partial   []byte
+		validator string
+	}{
+		{
+			name: "full download",
+			path: "/genesis.json",
+		},
+		{
+			name:      "resumed download",
+			path:      "/genesis.json",
+			partial:   genesis[:10],
+			validator: `"v2"`,
+		},
+		{
+			name:      "complete part file",
+			path:      "/genesis.json",
+			partial:   genesis,
+			validator: `"v2"`,
+		},
+		{
+			name:      "genesis changed since the part file",
+			path:      "/genesis.json",
+			partial:   []byte(`{"chain_id":"mars-0"`),
+			validator: `"v1"`,
+		},
+		{
+			name:    "part file without validator",
+			path:    "/genesis.json",
+			partial: []byte("stale"),
+		},
+		{
+			name:      "range not supported",
+			path:      "/norange",
+			partial:   []byte("stale"),
+			validator: `"v2"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			part := filepath.Join(t.TempDir(), "genesis.json.part")
+			if tt.partial != nil {
+				require.NoError(t, os.WriteFile(part, tt.partial, 0644))
+			}
+			if tt.validator != "" {
+				require.NoError(t, os.WriteFile(part+validatorExt, []byte(tt.validator), 0644))
+			}
+			require.NoError(t, downloadGenesis(context.Background(), server.Client(), server.URL+tt.path, part, nil))
+
+			got, err := os.ReadFile(part)
+			require.NoError(t, err)
+			require.Equal(t, genesis, got)
+		})
+	}
+}
+
+func TestFormatVestingAudit(t *testing.T) {
+	ctx := context.Background()
+
+	summary, err := formatVestingAudit(ctx, []network.VestingDiscrepancy{}, outputText, tableOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "no vesting account discrepancy", summary)
+
+	discrepancies := []network.VestingDiscrepancy{{
+		VestingAccount: networktypes.VestingAccount{Address: "spn1foo", TotalBalance: "10stake", Vesting: "20stake"},
+		Issue:          "the vesting coins exceed the total balance by 10stake",
+	}}
+	summary, err = formatVestingAudit(ctx, discrepancies, outputText, tableOptions{noHeader: true})
+	require.EqualError(t, err, "1 vesting accounts don't match their balance")
+	require.Contains(t, summary, "exceed the total balance by 10stake")
+}
+
+type genesisInformationByLaunchMock map[uint64]networktypes.GenesisInformation
+
+func (m genesisInformationByLaunchMock) GenesisInformation(_ context.Context, launchID uint64) (networktypes.GenesisInformation, error) {
+	return m[launchID], nil
+}
+
+func TestFormatGenesisDiff(t *testing.T) {
+	var (
+		ctx = context.Background()
+		gi  = genesisInformationByLaunchMock{
+			1: {
+				GenesisAccounts:   []networktypes.GenesisAccount{{Address: "spn1foo", Coins: "10stake"}},
+				GenesisValidators: []networktypes.GenesisValidator{{Address: "spn1foo", SelfDelegation: "10stake"}},
+			},
+			2: {
+				GenesisAccounts: []networktypes.GenesisAccount{
+					{Address: "spn1foo", Coins: "20stake"},
+					{Address: "spn1bar", Coins: "5stake"},
+				},
+				GenesisValidators: []networktypes.GenesisValidator{{Address: "spn1foo", SelfDelegation: "10stake"}},
+			},
+		}
+	)
+
+	summary, err := formatGenesisDiff(ctx, gi, 1, 2, chainShowAccounts, outputJSON, tableOptions{})
+	require.NoError(t, err)
+	require.JSONEq(t, `[
+		{"change": "added", "address": "spn1bar", "from": "", "to": "5stake"},
+		{"change": "changed", "address": "spn1foo", "from": "10stake", "to": "20stake"}
+	]`, summary)
+
+	summary, err = formatGenesisDiff(ctx, gi, 2, 1, chainShowAccounts, outputText, tableOptions{noHeader: true})
+	require.NoError(t, err)
+	require.Regexp(t, `(?m)^removed\s+spn1bar\s+5stake\s*$`, summary)
+
+	summary, err = formatGenesisDiff(ctx, gi, 1, 2, chainShowValidators, outputText, tableOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "no validators change since launch 1", summary)
+}
+
+func TestFormatPeerRPCs(t *testing.T) {
+	nodeID := strings.Repeat("a", 40)
+	validators := []networktypes.GenesisValidator{
+		{Address: "spn1foo", Peer: nodeID + "@1.1.1.1:26656"},
+		{Address: "spn1bar", Peer: "invalid"},
+		{Address: "spn1baz", Peer: nodeID + "@node.example.com:36656"},
+		{Address: "spn1qux", Peer: nodeID + "@1.1.1.1:26656"},
+	}
+
+	var errOut bytes.Buffer
+	summary, err := formatPeerRPCs(context.Background(), validators, outputText, &errOut)
+	require.NoError(t, err)
+	require.Equal(t, "1.1.1.1:26657,node.example.com:26657", summary)
+	require.Equal(t, "warning: 1 validators without a valid peer skipped\n", errOut.String())
+
+	summary, err = formatPeerRPCs(context.Background(), nil, outputJSON, nil)
+	require.NoError(t, err)
+	require.JSONEq(t, "[]", summary)
+}
+
+func TestPostProcessGenesis(t *testing.T) {
+	ctx := context.Background()
+
+	got, err := postProcessGenesis(ctx, strings.NewReader(`{"chain_id":"mars-1"}`), "tr -d '{}'", nil)
+	require.NoError(t, err)
+	require.Equal(t, `"chain_id":"mars-1"`, got)
+
+	var errOut bytes.Buffer
+	_, err = postProcessGenesis(ctx, strings.NewReader("{}"), "echo failed >&2; exit 2", &errOut)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `post-process command "echo failed >&2; exit 2" failed`)
+	require.Equal(t, "failed\n", errOut.String())
+}
+
+func TestPagerCommand(t *testing.T) {
+	var (
+		env     = map[string]string{}
+		getenv  = func(key string) string { return env[key] }
+		found   = func(string) (string, error) { return "/usr/bin/pager", nil }
+		missing = func(string) (string, error) { return "", errors.New("not found") }
+	)
+
+	args, ok := pagerCommand(getenv, found)
+	require.True(t, ok)
+	require.Equal(t, []string{"less", "-R"}, args)
+
+	env["PAGER"] = "more -d"
+	args, ok = pagerCommand(getenv, found)
+	require.True(t, ok)
+	require.Equal(t, []string{"more", "-d"}, args)
+
+	_, ok = pagerCommand(getenv, missing)
+	require.False(t, ok)
+
+	// the redirected outputs aren't paged
+	require.False(t, pageOutput(&bytes.Buffer{}, nil, "genesis"))
+}
@@ -0,0 +1,18 @@
+package starportcmd
+
+import "github.com/spf13/cobra"
+
+// NewNetworkCoordinator creates a new coordinator command that holds some other
+// sub commands related to the coordinators of the chains.
+func NewNetworkCoordinator() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "coordinator",
+		Short: "Look up coordinators",
+	}
+
+	c.AddCommand(
+		NewNetworkCoordinatorShow(),
+	)
+
+	return c
+}
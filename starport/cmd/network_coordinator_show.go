@@ -0,0 +1,79 @@
+package starportcmd
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/yaml"
+	"github.com/tendermint/starport/starport/services/network"
+)
+
+// NewNetworkCoordinatorShow creates a new coordinator show command to show
+// the profile of a coordinator.
+func NewNetworkCoordinatorShow() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "show [coordinator-id|address]",
+		Short: "Show the profile of a coordinator",
+		Long: `Show the profile of a coordinator registered on SPN with the IDs of the launches
+it coordinates. The coordinator is looked up by its ID or by its SPN address.`,
+		RunE: networkCoordinatorShowHandler,
+		Args: cobra.ExactArgs(1),
+	}
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagNetworkFrom())
+	c.Flags().AddFlagSet(flagSetHome())
+	return c
+}
+
+func networkCoordinatorShowHandler(cmd *cobra.Command, args []string) error {
+	nb, err := newNetworkBuilder(cmd)
+	if err != nil {
+		return err
+	}
+	defer nb.Cleanup()
+
+	n, err := nb.Network()
+	if err != nil {
+		return err
+	}
+
+	coordinatorID, err := coordinatorIDFromArg(cmd.Context(), n, args[0])
+	if err != nil {
+		return err
+	}
+
+	coordinator, err := n.Coordinator(cmd.Context(), coordinatorID)
+	if err != nil {
+		return err
+	}
+
+	coordinatorYaml, err := yaml.Marshal(cmd.Context(), coordinator)
+	if err != nil {
+		return err
+	}
+
+	nb.Spinner.Stop()
+	fmt.Fprintln(cmd.OutOrStdout(), coordinatorYaml)
+	return nil
+}
+
+// coordinatorIDFetcher fetches the ID of the coordinator with an SPN address.
+type coordinatorIDFetcher interface {
+	CoordinatorIDByAddress(ctx context.Context, address string) (uint64, error)
+}
+
+// coordinatorIDFromArg returns the coordinator ID of the argument, the argument is an address
+// unless it is a number. An unknown address fails like an unknown ID with a network.CoordinatorNotFoundError.
+func coordinatorIDFromArg(ctx context.Context, f coordinatorIDFetcher, arg string) (uint64, error) {
+	if coordinatorID, err := strconv.ParseUint(arg, 10, 64); err == nil {
+		return coordinatorID, nil
+	}
+	coordinatorID, err := f.CoordinatorIDByAddress(ctx, arg)
+	if errors.Is(err, network.ErrNotCoordinator) {
+		return 0, network.CoordinatorNotFoundError{Address: arg}
+	}
+	return coordinatorID, err
+}
@@ -0,0 +1,57 @@
+package starportcmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/services/network"
+)
+
+type coordinatorIDFetcherMock map[string]uint64
+
+func (m coordinatorIDFetcherMock) CoordinatorIDByAddress(_ context.Context, address string) (uint64, error) {
+	id, ok := m[address]
+	if !ok {
+		return 0, network.ErrNotCoordinator
+	}
+	return id, nil
+}
+
+func TestCoordinatorIDFromArg(t *testing.T) {
+	f := coordinatorIDFetcherMock{"spn1a": 3}
+
+	tests := []struct {
+		name string
+		arg  string
+		want uint64
+		err  error
+	}{
+		{
+			name: "id",
+			arg:  "5",
+			want: 5,
+		},
+		{
+			name: "address",
+			arg:  "spn1a",
+			want: 3,
+		},
+		{
+			name: "unknown address",
+			arg:  "spn1b",
+			err:  network.CoordinatorNotFoundError{Address: "spn1b"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := coordinatorIDFromArg(context.Background(), f, tt.arg)
+			if tt.err != nil {
+				require.Equal(t, tt.err, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
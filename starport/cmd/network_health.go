@@ -0,0 +1,101 @@
+package starportcmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+)
+
+// NewNetworkHealth creates a new health command to check the SPN node is reachable.
+func NewNetworkHealth() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "health",
+		Short: "Check the SPN node is reachable",
+		Long: `Query the status of the SPN node and show its network, version, latest height
+and the latency of the query. The command fails when the node can't be reached,
+which isolates the connectivity issues from the issues of the launch data.`,
+		Args: cobra.NoArgs,
+		RunE: networkHealthHandler,
+	}
+	c.Flags().StringP(flagOutput, "o", outputText, "Output format (text|json)")
+	c.Flags().Duration(flagTimeout, defaultTimeout, "Maximum duration of the status query, 0 disables the timeout")
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	return c
+}
+
+func networkHealthHandler(cmd *cobra.Command, _ []string) error {
+	output, _ := cmd.Flags().GetString(flagOutput)
+	if output != outputText && output != outputJSON {
+		return fmt.Errorf("invalid output format %s, use one of: %s, %s", output, outputText, outputJSON)
+	}
+	timeout, _ := cmd.Flags().GetDuration(flagTimeout)
+
+	nb, err := newNetworkBuilder(cmd)
+	if err != nil {
+		return errors.Wrapf(err, "SPN node %s is not reachable", spnNodeAddress)
+	}
+	defer nb.Cleanup()
+
+	nb.Spinner.SetText("querying SPN status...")
+
+	ctx, cancel := contextWithTimeout(cmd.Context(), timeout)
+	defer cancel()
+
+	health, err := pingSPN(ctx, nb.cc.RPC)
+	if err != nil {
+		return errors.Wrapf(timeoutError(ctx, timeout, err), "SPN node %s is not reachable", spnNodeAddress)
+	}
+	health.NodeAddress = spnNodeAddress
+
+	nb.StopSpinner()
+	if output == outputJSON {
+		out, err := formatJSON(health)
+		if err != nil {
+			return err
+		}
+		fmt.Println(out)
+		return nil
+	}
+	fmt.Printf("%s SPN node %s is reachable\n", clispinner.OK, health.NodeAddress)
+	fmt.Printf("network: %s, version: %s, height: %d, latency: %s\n",
+		health.Network,
+		health.Version,
+		health.LatestHeight,
+		health.Latency,
+	)
+	return nil
+}
+
+// statusFetcher fetches the status of a Tendermint node.
+type statusFetcher interface {
+	Status(ctx context.Context) (*ctypes.ResultStatus, error)
+}
+
+// spnHealth is the status of the SPN node with the latency of the status query.
+type spnHealth struct {
+	NodeAddress  string `json:"nodeAddress"`
+	Network      string `json:"network"`
+	Version      string `json:"version"`
+	LatestHeight int64  `json:"latestHeight"`
+	Latency      string `json:"latency"`
+}
+
+// pingSPN queries the status of the SPN node and measures the latency of the query.
+func pingSPN(ctx context.Context, node statusFetcher) (spnHealth, error) {
+	start := time.Now()
+	status, err := node.Status(ctx)
+	if err != nil {
+		return spnHealth{}, err
+	}
+	return spnHealth{
+		Network:      status.NodeInfo.Network,
+		Version:      status.NodeInfo.Version,
+		LatestHeight: status.SyncInfo.LatestBlockHeight,
+		Latency:      time.Since(start).Round(time.Millisecond).String(),
+	}, nil
+}
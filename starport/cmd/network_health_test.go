@@ -0,0 +1,40 @@
+package starportcmd
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/p2p"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+)
+
+type statusFetcherMock struct {
+	status *ctypes.ResultStatus
+	err    error
+}
+
+func (m statusFetcherMock) Status(context.Context) (*ctypes.ResultStatus, error) {
+	return m.status, m.err
+}
+
+func TestPingSPN(t *testing.T) {
+	t.Run("reachable node", func(t *testing.T) {
+		health, err := pingSPN(context.Background(), statusFetcherMock{
+			status: &ctypes.ResultStatus{
+				NodeInfo: p2p.DefaultNodeInfo{Network: "spn-1", Version: "0.34.14"},
+				SyncInfo: ctypes.SyncInfo{LatestBlockHeight: 42},
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, "spn-1", health.Network)
+		require.Equal(t, "0.34.14", health.Version)
+		require.Equal(t, int64(42), health.LatestHeight)
+		require.NotEmpty(t, health.Latency)
+	})
+	t.Run("unreachable node", func(t *testing.T) {
+		_, err := pingSPN(context.Background(), statusFetcherMock{err: errors.New("connection refused")})
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,182 @@
+package starportcmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
+	"github.com/tendermint/starport/starport/services/network/networkchain"
+)
+
+func TestGetSPNNodeAddress(t *testing.T) {
+	const (
+		envAddress  = "http://env:26657"
+		flagAddress = "http://flag:26657"
+	)
+
+	tests := []struct {
+		name string
+		env  string
+		args []string
+		want string
+	}{
+		{
+			name: "default",
+			want: spnNodeAddressAlpha,
+		},
+		{
+			name: "env set",
+			env:  envAddress,
+			want: envAddress,
+		},
+		{
+			name: "flag set",
+			args: []string{"--" + flagSPNNodeAddress, flagAddress},
+			want: flagAddress,
+		},
+		{
+			name: "env and flag set",
+			env:  envAddress,
+			args: []string{"--" + flagSPNNodeAddress, flagAddress},
+			want: flagAddress,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prevEnv, hasPrevEnv := os.LookupEnv(envSPNNodeAddress)
+			prevAddress := spnNodeAddress
+			defer func() {
+				spnNodeAddress = prevAddress
+				if hasPrevEnv {
+					os.Setenv(envSPNNodeAddress, prevEnv)
+				} else {
+					os.Unsetenv(envSPNNodeAddress)
+				}
+			}()
+
+			if tt.env != "" {
+				require.NoError(t, os.Setenv(envSPNNodeAddress, tt.env))
+			} else {
+				require.NoError(t, os.Unsetenv(envSPNNodeAddress))
+			}
+
+			cmd := &cobra.Command{}
+			cmd.Flags().StringVar(&spnNodeAddress, flagSPNNodeAddress, spnNodeAddressAlpha, "")
+			require.NoError(t, cmd.ParseFlags(tt.args))
+
+			require.Equal(t, tt.want, getSPNNodeAddress(cmd))
+		})
+	}
+}
+
+func TestGetSPNTransport(t *testing.T) {
+	const nodeAddress = "https://rpc.spn.local:443"
+
+	tests := []struct {
+		name          string
+		insecure      bool
+		tlsCA         string
+		skipVerify    bool
+		wantAddress   string
+		wantTLSConfig bool
+		wantErr       bool
+	}{
+		{
+			name:        "default",
+			wantAddress: nodeAddress,
+		},
+		{
+			name:        "insecure",
+			insecure:    true,
+			wantAddress: "http://rpc.spn.local:443",
+		},
+		{
+			name:          "skip verify",
+			skipVerify:    true,
+			wantAddress:   nodeAddress,
+			wantTLSConfig: true,
+		},
+		{
+			name:    "missing CA",
+			tlsCA:   "/nonexistent/ca.pem",
+			wantErr: true,
+		},
+		{
+			name:       "insecure and skip verify",
+			insecure:   true,
+			skipVerify: true,
+			wantErr:    true,
+		},
+		{
+			name:       "CA and skip verify",
+			tlsCA:      "ca.pem",
+			skipVerify: true,
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				spnInsecure, spnTLSCA, spnTLSSkipVerify = false, "", false
+			}()
+			spnInsecure, spnTLSCA, spnTLSSkipVerify = tt.insecure, tt.tlsCA, tt.skipVerify
+
+			address, tlsConfig, err := getSPNTransport(nodeAddress)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantAddress, address)
+			require.Equal(t, tt.wantTLSConfig, tlsConfig != nil)
+		})
+	}
+}
+
+func TestAccountFromFile(t *testing.T) {
+	registry, err := cosmosaccount.New(
+		cosmosaccount.WithHome(t.TempDir()),
+		cosmosaccount.WithKeyringBackend(cosmosaccount.KeyringTest),
+	)
+	require.NoError(t, err)
+	alice, _, err := registry.Create("alice")
+	require.NoError(t, err)
+	_, _, err = registry.Create("bob")
+	require.NoError(t, err)
+
+	writeFromFile := func(t *testing.T, content string) string {
+		path := filepath.Join(t.TempDir(), "from")
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+		return path
+	}
+
+	tests := []struct {
+		name    string
+		content string
+		from    string
+		wantErr string
+	}{
+		{name: "name", content: "alice\n"},
+		{name: "address", content: alice.Address(networkchain.SPN)},
+		{name: "agreeing --from name", content: "alice", from: "alice"},
+		{name: "agreeing --from address", content: "alice", from: alice.Address(networkchain.SPN)},
+		{name: "disagreeing --from", content: "alice", from: "bob", wantErr: "disagree"},
+		{name: "empty file", content: " \n", wantErr: "is empty"},
+		{name: "unknown address", content: "spn1dd246yq6z5vzjz9gh8cff46pll75yyl8c5tt7g", wantErr: "no account"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			account, err := accountFromFile(registry, writeFromFile(t, tt.content), tt.from)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "alice", account.Name)
+		})
+	}
+}
@@ -30,6 +30,6 @@ func main() {
 			fmt.Println(err)
 		}
 
-		os.Exit(1)
+		os.Exit(starportcmd.ExitCode(err))
 	}
 }
@@ -0,0 +1,60 @@
+// Package clipboard copies text into the system clipboard with the clipboard command of the platform.
+package clipboard
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// ErrUnavailable is returned when the platform has no clipboard command, like the headless systems.
+var ErrUnavailable = errors.New("no clipboard available")
+
+// command is a clipboard command reading the text to copy from its stdin.
+type command struct {
+	name string
+	args []string
+}
+
+// Copy copies the text into the system clipboard.
+func Copy(ctx context.Context, text string) error {
+	c, ok := clipboardCommand(runtime.GOOS, os.Getenv, exec.LookPath)
+	if !ok {
+		return ErrUnavailable
+	}
+	cmd := exec.CommandContext(ctx, c.name, c.args...)
+	cmd.Stdin = strings.NewReader(text)
+	return cmd.Run()
+}
+
+// clipboardCommand returns the first clipboard command of the platform available in the path,
+// the X11 and Wayland commands are only used when a display is set.
+func clipboardCommand(goos string, getenv func(string) string, lookPath func(string) (string, error)) (command, bool) {
+	var candidates []command
+	switch goos {
+	case "darwin":
+		candidates = []command{{name: "pbcopy"}}
+	case "windows":
+		candidates = []command{{name: "clip"}}
+	default:
+		if getenv("WAYLAND_DISPLAY") != "" {
+			candidates = append(candidates, command{name: "wl-copy"})
+		}
+		if getenv("DISPLAY") != "" {
+			candidates = append(candidates,
+				command{name: "xclip", args: []string{"-selection", "clipboard"}},
+				command{name: "xsel", args: []string{"--clipboard", "--input"}},
+			)
+		}
+	}
+
+	for _, c := range candidates {
+		if _, err := lookPath(c.name); err == nil {
+			return c, true
+		}
+	}
+	return command{}, false
+}
@@ -0,0 +1,63 @@
+package clipboard
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClipboardCommand(t *testing.T) {
+	var (
+		env = func(vars map[string]string) func(string) string {
+			return func(key string) string { return vars[key] }
+		}
+		installed = func(names ...string) func(string) (string, error) {
+			return func(name string) (string, error) {
+				for _, n := range names {
+					if n == name {
+						return "/usr/bin/" + name, nil
+					}
+				}
+				return "", errors.New("not found")
+			}
+		}
+	)
+
+	tests := []struct {
+		name     string
+		goos     string
+		env      map[string]string
+		commands []string
+		want     string
+		wantOK   bool
+	}{
+		{name: "macos", goos: "darwin", commands: []string{"pbcopy"}, want: "pbcopy", wantOK: true},
+		{name: "windows", goos: "windows", commands: []string{"clip"}, want: "clip", wantOK: true},
+		{
+			name:     "x11",
+			goos:     "linux",
+			env:      map[string]string{"DISPLAY": ":0"},
+			commands: []string{"xsel"},
+			want:     "xsel",
+			wantOK:   true,
+		},
+		{
+			name:     "wayland",
+			goos:     "linux",
+			env:      map[string]string{"WAYLAND_DISPLAY": "wayland-0", "DISPLAY": ":0"},
+			commands: []string{"wl-copy", "xclip"},
+			want:     "wl-copy",
+			wantOK:   true,
+		},
+		{name: "headless", goos: "linux", commands: []string{"xclip"}},
+		{name: "no command", goos: "linux", env: map[string]string{"DISPLAY": ":0"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, ok := clipboardCommand(tt.goos, env(tt.env), installed(tt.commands...))
+			require.Equal(t, tt.wantOK, ok)
+			require.Equal(t, tt.want, c.name)
+		})
+	}
+}
@@ -3,9 +3,12 @@ package cosmosclient
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/hex"
 	"fmt"
 	"io"
+	"net"
+	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
@@ -31,6 +34,7 @@ import (
 	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
 	"github.com/tendermint/starport/starport/pkg/cosmosfaucet"
 	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+	jsonrpcclient "github.com/tendermint/tendermint/rpc/jsonrpc/client"
 )
 
 // FaucetTransferEnsureDuration is the duration that BroadcastTx will wait when a faucet transfer
@@ -68,6 +72,9 @@ type Client struct {
 	addressPrefix string
 
 	nodeAddress string
+	tlsConfig   *tls.Config
+	keepAlive   time.Duration
+	httpClient  *http.Client
 	out         io.Writer
 	chainID     string
 
@@ -116,6 +123,21 @@ func WithNodeAddress(addr string) Option {
 	}
 }
 
+// WithTLSConfig sets the TLS configuration used to reach a node served over https.
+func WithTLSConfig(config *tls.Config) Option {
+	return func(c *Client) {
+		c.tlsConfig = config
+	}
+}
+
+// WithKeepAlive sets the period of the TCP keep-alive probes of the connections to the node,
+// so the idle connections aren't dropped by the network. The system default is used when not provided.
+func WithKeepAlive(period time.Duration) Option {
+	return func(c *Client) {
+		c.keepAlive = period
+	}
+}
+
 func WithAddressPrefix(prefix string) Option {
 	return func(c *Client) {
 		c.addressPrefix = prefix
@@ -153,7 +175,7 @@ func New(ctx context.Context, options ...Option) (Client, error) {
 		apply(&c)
 	}
 
-	if c.RPC, err = rpchttp.New(c.nodeAddress, "/websocket"); err != nil {
+	if c.RPC, c.httpClient, err = newRPC(c.nodeAddress, c.tlsConfig, c.keepAlive); err != nil {
 		return Client{}, err
 	}
 
@@ -187,6 +209,49 @@ func New(ctx context.Context, options ...Option) (Client, error) {
 	return c, nil
 }
 
+// newRPC creates a Tendermint RPC client along with the HTTP client it reuses for all its requests,
+// the TLS configuration and the keep-alive period apply if set.
+func newRPC(nodeAddress string, tlsConfig *tls.Config, keepAlive time.Duration) (*rpchttp.HTTP, *http.Client, error) {
+	client, err := jsonrpcclient.DefaultHTTPClient(nodeAddress)
+	if err != nil {
+		return nil, nil, err
+	}
+	transport, ok := client.Transport.(*http.Transport)
+	if !ok {
+		return nil, nil, errors.New("unexpected RPC transport")
+	}
+	transport.TLSClientConfig = tlsConfig
+	if keepAlive > 0 {
+		dial := transport.Dial
+		transport.Dial = func(network, addr string) (net.Conn, error) {
+			conn, err := dial(network, addr)
+			tcpConn, ok := conn.(*net.TCPConn)
+			if err != nil || !ok {
+				return conn, err
+			}
+			if err := tcpConn.SetKeepAlive(true); err != nil {
+				conn.Close()
+				return nil, err
+			}
+			if err := tcpConn.SetKeepAlivePeriod(keepAlive); err != nil {
+				conn.Close()
+				return nil, err
+			}
+			return conn, nil
+		}
+	}
+
+	rpc, err := rpchttp.NewWithClient(nodeAddress, "/websocket", client)
+	return rpc, client, err
+}
+
+// Close closes the idle connections to the node.
+func (c Client) Close() {
+	if c.httpClient != nil {
+		c.httpClient.CloseIdleConnections()
+	}
+}
+
 func (c Client) Account(accountName string) (cosmosaccount.Account, error) {
 	return c.AccountRegistry.GetByName(accountName)
 }
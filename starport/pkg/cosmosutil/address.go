@@ -1,11 +1,31 @@
 package cosmosutil
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/types/bech32"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
+// maxPrefixLength is the maximum length of a bech32 prefix.
+const maxPrefixLength = 83
+
+// moduleAccountNames are the names of the module accounts of the standard SDK modules.
+var moduleAccountNames = []string{
+	authtypes.FeeCollectorName,
+	distrtypes.ModuleName,
+	govtypes.ModuleName,
+	minttypes.ModuleName,
+	stakingtypes.BondedPoolName,
+	stakingtypes.NotBondedPoolName,
+}
+
 // ChangeAddressPrefix returns the address with another prefix
 func ChangeAddressPrefix(address, newPrefix string) (string, error) {
 	if newPrefix == "" {
@@ -23,3 +43,34 @@ func GetAddressPrefix(address string) (string, error) {
 	prefix, _, err := bech32.DecodeAndConvert(address)
 	return prefix, err
 }
+
+// ValidateAddressPrefix checks if the prefix can be used as a bech32 prefix,
+// only lowercase letters and digits are accepted.
+func ValidateAddressPrefix(prefix string) error {
+	if prefix == "" {
+		return errors.New("empty prefix")
+	}
+	if len(prefix) > maxPrefixLength {
+		return fmt.Errorf("prefix %s is longer than %d characters", prefix, maxPrefixLength)
+	}
+	for _, r := range prefix {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') {
+			return fmt.Errorf("prefix %s contains the invalid character %q", prefix, r)
+		}
+	}
+	return nil
+}
+
+// IsModuleAddress checks if the address is the address of the module account of a standard SDK module.
+func IsModuleAddress(address string) bool {
+	_, addr, err := bech32.DecodeAndConvert(address)
+	if err != nil {
+		return false
+	}
+	for _, name := range moduleAccountNames {
+		if bytes.Equal(addr, authtypes.NewModuleAddress(name)) {
+			return true
+		}
+	}
+	return false
+}
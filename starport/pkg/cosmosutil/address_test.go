@@ -1,6 +1,7 @@
 package cosmosutil_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -78,3 +79,21 @@ func TestGetPrefix(t *testing.T) {
 	_, err = cosmosutil.GetAddressPrefix("mars1c6ac48k2ur9tl3tf0cpntlw5068kvp8xf4xq37")
 	require.Error(t, err)
 }
+
+func TestValidateAddressPrefix(t *testing.T) {
+	require.NoError(t, cosmosutil.ValidateAddressPrefix("osmo"))
+	require.NoError(t, cosmosutil.ValidateAddressPrefix("earth2"))
+	require.Error(t, cosmosutil.ValidateAddressPrefix(""))
+	require.Error(t, cosmosutil.ValidateAddressPrefix("Osmo"))
+	require.Error(t, cosmosutil.ValidateAddressPrefix("osmo-1"))
+	require.Error(t, cosmosutil.ValidateAddressPrefix(strings.Repeat("a", 84)))
+}
+
+func TestIsModuleAddress(t *testing.T) {
+	// fee collector
+	require.True(t, cosmosutil.IsModuleAddress("cosmos17xpfvakm2amg962yls6f84z3kell8c5lserqta"))
+	require.False(t, cosmosutil.IsModuleAddress("cosmos1dd246yq6z5vzjz9gh8cff46pll75yyl8ygndsj"))
+
+	// invalid bech32 address
+	require.False(t, cosmosutil.IsModuleAddress("mars1c6ac48k2ur9tl3tf0cpntlw5068kvp8xf4xq37"))
+}
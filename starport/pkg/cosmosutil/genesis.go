@@ -7,10 +7,14 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"os"
 	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 )
 
 const genesisTimeField = "genesis_time"
@@ -23,9 +27,33 @@ type ChainGenesis struct {
 				Address string `json:"address"`
 			} `json:"accounts"`
 		} `json:"auth"`
+		Bank struct {
+			DenomMetadata []DenomMetadata `json:"denom_metadata"`
+		} `json:"bank"`
 	} `json:"app_state"`
 }
 
+// DenomMetadata is the bank metadata of a denom with its units.
+type DenomMetadata struct {
+	Base       string `json:"base"`
+	Display    string `json:"display"`
+	DenomUnits []struct {
+		Denom    string `json:"denom"`
+		Exponent uint32 `json:"exponent"`
+	} `json:"denom_units"`
+}
+
+// DisplayExponent returns the exponent of the display unit of the denom, it fails when
+// the display unit isn't one of the denom units.
+func (m DenomMetadata) DisplayExponent() (uint32, bool) {
+	for _, unit := range m.DenomUnits {
+		if unit.Denom == m.Display {
+			return unit.Exponent, true
+		}
+	}
+	return 0, false
+}
+
 // HasAccount check if account exist into the genesis account
 func (g ChainGenesis) HasAccount(address string) bool {
 	for _, account := range g.AppState.Auth.Accounts {
@@ -112,3 +140,71 @@ func GenesisAndHashFromURL(ctx context.Context, url string) (genesis []byte, has
 
 	return genesis, hexHash, nil
 }
+
+// GenesisError is a genesis validation error located by its JSON path.
+type GenesisError struct {
+	Path string
+	Err  error
+}
+
+// Error implements error.
+func (e GenesisError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Path, e.Err)
+}
+
+// ValidateGenesis statically checks the genesis is well-formed JSON and that its chain ID,
+// genesis time and bank balances are valid. The first invalid field is returned as a GenesisError.
+func ValidateGenesis(genesis []byte) error {
+	var doc struct {
+		ChainID     string          `json:"chain_id"`
+		GenesisTime string          `json:"genesis_time"`
+		AppState    json.RawMessage `json:"app_state"`
+	}
+	if err := json.Unmarshal(genesis, &doc); err != nil {
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			return fmt.Errorf("invalid JSON at offset %d: %w", syntaxErr.Offset, err)
+		}
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			return GenesisError{Path: "$." + typeErr.Field, Err: err}
+		}
+		return err
+	}
+
+	if doc.ChainID == "" {
+		return GenesisError{Path: "$.chain_id", Err: errors.New("empty chain ID")}
+	}
+	if _, err := time.Parse(time.RFC3339Nano, doc.GenesisTime); err != nil {
+		return GenesisError{Path: "$." + genesisTimeField, Err: err}
+	}
+	if len(doc.AppState) == 0 {
+		return GenesisError{Path: "$.app_state", Err: errors.New("missing app state")}
+	}
+
+	var appState struct {
+		Bank struct {
+			Balances []struct {
+				Address string    `json:"address"`
+				Coins   sdk.Coins `json:"coins"`
+			} `json:"balances"`
+		} `json:"bank"`
+	}
+	if err := json.Unmarshal(doc.AppState, &appState); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			return GenesisError{Path: "$.app_state." + typeErr.Field, Err: err}
+		}
+		return GenesisError{Path: "$.app_state", Err: err}
+	}
+	for i, balance := range appState.Bank.Balances {
+		path := fmt.Sprintf("$.app_state.bank.balances[%d]", i)
+		if _, _, err := bech32.DecodeAndConvert(balance.Address); err != nil {
+			return GenesisError{Path: path + ".address", Err: err}
+		}
+		if err := balance.Coins.Validate(); err != nil {
+			return GenesisError{Path: path + ".coins", Err: err}
+		}
+	}
+	return nil
+}
@@ -44,3 +44,66 @@ func TestSetGenesisTime(t *testing.T) {
 	require.Equal(t, "bar", actual.Foo)
 	require.Equal(t, rfcTime, actual.GenesisTime)
 }
+
+func TestValidateGenesis(t *testing.T) {
+	tests := []struct {
+		name    string
+		genesis string
+		path    string
+		err     bool
+	}{
+		{
+			name: "valid genesis",
+			genesis: `{"chain_id":"foo","genesis_time":"2020-09-13T12:26:40Z","app_state":{"bank":{"balances":[
+				{"address":"cosmos1dd246yq6z5vzjz9gh8cff46pll75yyl8ygndsj","coins":[{"denom":"stake","amount":"1000"}]}]}}}`,
+		},
+		{
+			name:    "truncated genesis",
+			genesis: `{"chain_id":"foo","genesis_time":"2020-09-13T12:26:40Z","app_st`,
+			err:     true,
+		},
+		{
+			name:    "empty chain id",
+			genesis: `{"genesis_time":"2020-09-13T12:26:40Z","app_state":{}}`,
+			path:    "$.chain_id",
+		},
+		{
+			name:    "invalid genesis time",
+			genesis: `{"chain_id":"foo","genesis_time":"foobar","app_state":{}}`,
+			path:    "$.genesis_time",
+		},
+		{
+			name:    "missing app state",
+			genesis: `{"chain_id":"foo","genesis_time":"2020-09-13T12:26:40Z"}`,
+			path:    "$.app_state",
+		},
+		{
+			name: "invalid balance address",
+			genesis: `{"chain_id":"foo","genesis_time":"2020-09-13T12:26:40Z","app_state":{"bank":{"balances":[
+				{"address":"foo","coins":[]}]}}}`,
+			path: "$.app_state.bank.balances[0].address",
+		},
+		{
+			name: "invalid balance coins",
+			genesis: `{"chain_id":"foo","genesis_time":"2020-09-13T12:26:40Z","app_state":{"bank":{"balances":[
+				{"address":"cosmos1dd246yq6z5vzjz9gh8cff46pll75yyl8ygndsj","coins":[{"denom":"stake","amount":"0"}]}]}}}`,
+			path: "$.app_state.bank.balances[0].coins",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := cosmosutil.ValidateGenesis([]byte(tt.genesis))
+			if tt.path != "" {
+				var genesisErr cosmosutil.GenesisError
+				require.ErrorAs(t, err, &genesisErr)
+				require.Equal(t, tt.path, genesisErr.Path)
+				return
+			}
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
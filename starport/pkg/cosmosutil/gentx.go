@@ -19,10 +19,13 @@ type (
 		DelegatorAddress string
 		PubKey           PubKey
 		SelfDelegation   sdk.Coin
+		Moniker          string
+		Memo             string
 	}
 	// StargateGentx represents the stargate gentx file
 	StargateGentx struct {
 		Body struct {
+			Memo     string `json:"memo"`
 			Messages []struct {
 				DelegatorAddress string `json:"delegator_address"`
 				ValidatorAddress string `json:"validator_address"`
@@ -33,6 +36,9 @@ type (
 					Denom  string `json:"denom"`
 					Amount string `json:"amount"`
 				} `json:"value"`
+				Description struct {
+					Moniker string `json:"moniker"`
+				} `json:"description"`
 			} `json:"messages"`
 		} `json:"body"`
 	}
@@ -75,6 +81,8 @@ func ParseGentx(gentx []byte) (info GentxInfo, file []byte, err error) {
 
 	info.DelegatorAddress = stargateGentx.Body.Messages[0].DelegatorAddress
 	info.PubKey = []byte(stargateGentx.Body.Messages[0].PubKey.Key)
+	info.Moniker = stargateGentx.Body.Messages[0].Description.Moniker
+	info.Memo = stargateGentx.Body.Memo
 
 	amount, ok := sdk.NewIntFromString(stargateGentx.Body.Messages[0].Value.Amount)
 	if !ok {
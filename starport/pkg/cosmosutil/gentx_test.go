@@ -109,6 +109,8 @@ func TestParseGentx(t *testing.T) {
 					Denom:  "stake",
 					Amount: sdk.NewInt(95000000),
 				},
+				Moniker: "default",
+				Memo:    "9b1f4adbfb0c0b513040d914bfb717303c0eaa71@192.168.0.148:26656",
 			},
 		}, {
 			name:      "parse gentx file 2",
@@ -120,6 +122,8 @@ func TestParseGentx(t *testing.T) {
 					Denom:  "stake",
 					Amount: sdk.NewInt(95000000),
 				},
+				Moniker: "alice",
+				Memo:    "a412c917cb29f73cc3ad0592bbd0152fe0e690bd@192.168.0.148:26656",
 			},
 		}, {
 			name:      "parse invalid file",
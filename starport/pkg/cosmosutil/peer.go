@@ -1,9 +1,16 @@
 package cosmosutil
 
 import (
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strconv"
 	"strings"
 )
 
+// nodeIDLength is the length of a hex encoded Tendermint node ID.
+const nodeIDLength = 40
+
 // VerifyPeerFormat checks if the peer address format is valid
 func VerifyPeerFormat(peer string) bool {
 	// Check the format of the peer
@@ -15,3 +22,31 @@ func VerifyPeerFormat(peer string) bool {
 	}
 	return true
 }
+
+// ValidatePeer checks if the peer is a valid Tendermint peer address in the `<node-id>@<host>:<port>` format
+func ValidatePeer(peer string) error {
+	nodeHost := strings.Split(peer, "@")
+	if len(nodeHost) != 2 {
+		return fmt.Errorf("peer %s is not in the <node-id>@<host>:<port> format", peer)
+	}
+
+	nodeID, addr := nodeHost[0], nodeHost[1]
+	if len(nodeID) != nodeIDLength {
+		return fmt.Errorf("node ID of peer %s must be %d characters long", peer, nodeIDLength)
+	}
+	if _, err := hex.DecodeString(nodeID); err != nil {
+		return fmt.Errorf("node ID of peer %s is not hex encoded", peer)
+	}
+
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return fmt.Errorf("invalid address for peer %s: %s", peer, err)
+	}
+	if host == "" {
+		return fmt.Errorf("peer %s has no host", peer)
+	}
+	if p, err := strconv.ParseUint(port, 10, 16); err != nil || p == 0 {
+		return fmt.Errorf("peer %s has an invalid port %s", peer, port)
+	}
+	return nil
+}
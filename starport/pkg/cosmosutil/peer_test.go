@@ -39,3 +39,60 @@ func TestVerifyPeerFormat(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatePeer(t *testing.T) {
+	tests := []struct {
+		name    string
+		peer    string
+		wantErr bool
+	}{
+		{
+			name: "valid peer",
+			peer: "e7ab6c9cd5ea1e4f5fc7a0dbd7ad0a0f6fbfa38b@0.0.0.0:26656",
+		},
+		{
+			name: "valid peer with host name",
+			peer: "e7ab6c9cd5ea1e4f5fc7a0dbd7ad0a0f6fbfa38b@node.example.com:26656",
+		},
+		{
+			name:    "no node ID",
+			peer:    "0.0.0.0:26656",
+			wantErr: true,
+		},
+		{
+			name:    "short node ID",
+			peer:    "e7ab6c9c@0.0.0.0:26656",
+			wantErr: true,
+		},
+		{
+			name:    "non hex node ID",
+			peer:    "z7ab6c9cd5ea1e4f5fc7a0dbd7ad0a0f6fbfa38b@0.0.0.0:26656",
+			wantErr: true,
+		},
+		{
+			name:    "no port",
+			peer:    "e7ab6c9cd5ea1e4f5fc7a0dbd7ad0a0f6fbfa38b@0.0.0.0",
+			wantErr: true,
+		},
+		{
+			name:    "invalid port",
+			peer:    "e7ab6c9cd5ea1e4f5fc7a0dbd7ad0a0f6fbfa38b@0.0.0.0:foo",
+			wantErr: true,
+		},
+		{
+			name:    "no host",
+			peer:    "e7ab6c9cd5ea1e4f5fc7a0dbd7ad0a0f6fbfa38b@:26656",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePeer(tt.peer)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -1,8 +1,11 @@
 package entrywriter
 
 import (
+	"encoding/csv"
 	"fmt"
 	"io"
+	"math/big"
+	"sort"
 	"strings"
 	"text/tabwriter"
 
@@ -22,6 +25,16 @@ func MustWrite(out io.Writer, header []string, entries ...[]string) error {
 
 // Write writes into out the tabulated entries
 func Write(out io.Writer, header []string, entries ...[]string) error {
+	return write(out, true, header, entries...)
+}
+
+// WriteNoHeader writes into out the tabulated entries without the header line,
+// the header is only used to check the format of the entries
+func WriteNoHeader(out io.Writer, header []string, entries ...[]string) error {
+	return write(out, false, header, entries...)
+}
+
+func write(out io.Writer, withHeader bool, header []string, entries ...[]string) error {
 	w := &tabwriter.Writer{}
 	w.Init(out, 0, 8, 0, '\t', 0)
 
@@ -40,16 +53,18 @@ func Write(out io.Writer, header []string, entries ...[]string) error {
 	}
 
 	// write header
-	if _, err := fmt.Fprintln(w, formatLine(header, true)); err != nil {
-		return err
+	if withHeader {
+		if _, err := fmt.Fprintln(w, formatLine(header, true)); err != nil {
+			return err
+		}
 	}
 
 	// write entries
 	for i, entry := range entries {
 		if len(entry) != len(header) {
-			return errors.Wrapf(ErrInvalidFormat, "entry %d doesn't match header length", i)
+			return errors.Wrapf(ErrInvalidFormat, "row %d has %d columns, expected %d", i, len(entry), len(header))
 		}
-		if _, err := fmt.Fprintf(w, formatLine(entry, false)+"\n"); err != nil {
+		if _, err := fmt.Fprintln(w, formatLine(entry, false)); err != nil {
 			return err
 		}
 	}
@@ -59,3 +74,211 @@ func Write(out io.Writer, header []string, entries ...[]string) error {
 	}
 	return w.Flush()
 }
+
+// WriteCSV writes into out the entries in the RFC 4180 CSV format
+func WriteCSV(out io.Writer, header []string, entries ...[]string) error {
+	return writeCSV(out, true, header, entries...)
+}
+
+// WriteCSVNoHeader writes into out the entries in the RFC 4180 CSV format without the header record
+func WriteCSVNoHeader(out io.Writer, header []string, entries ...[]string) error {
+	return writeCSV(out, false, header, entries...)
+}
+
+func writeCSV(out io.Writer, withHeader bool, header []string, entries ...[]string) error {
+	w, err := NewCSVWriter(out, withHeader, header)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if err := w.Write(entry); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
+
+// CSVWriter writes entries in the RFC 4180 CSV format one at a time, so the entries
+// don't have to be held in memory to be written
+type CSVWriter struct {
+	w      *csv.Writer
+	header []string
+	rows   int
+}
+
+// NewCSVWriter returns a CSV writer writing into out, the header record is written first if withHeader
+func NewCSVWriter(out io.Writer, withHeader bool, header []string) (*CSVWriter, error) {
+	if len(header) == 0 {
+		return nil, errors.Wrap(ErrInvalidFormat, "empty header")
+	}
+
+	w := &CSVWriter{w: csv.NewWriter(out), header: header}
+	if withHeader {
+		if err := w.w.Write(header); err != nil {
+			return nil, err
+		}
+	}
+	return w, nil
+}
+
+// Write writes the entry, it must have as many columns as the header
+func (w *CSVWriter) Write(entry []string) error {
+	if len(entry) != len(w.header) {
+		return errors.Wrapf(ErrInvalidFormat, "row %d has %d columns, expected %d", w.rows, len(entry), len(w.header))
+	}
+	w.rows++
+	return w.w.Write(entry)
+}
+
+// Flush writes the buffered entries into the underlying writer
+func (w *CSVWriter) Flush() error {
+	w.w.Flush()
+	return w.w.Error()
+}
+
+// WriteMarkdown writes into out the entries as a GitHub flavored markdown table,
+// the pipes of the cells are escaped
+func WriteMarkdown(out io.Writer, header []string, entries ...[]string) error {
+	if len(header) == 0 {
+		return errors.Wrap(ErrInvalidFormat, "empty header")
+	}
+
+	formatLine := func(line []string) string {
+		cells := make([]string, 0, len(line))
+		for _, cell := range line {
+			cell = strings.ReplaceAll(cell, "|", "\\|")
+			cell = strings.ReplaceAll(cell, "\n", " ")
+			cells = append(cells, cell)
+		}
+		return "| " + strings.Join(cells, " | ") + " |\n"
+	}
+
+	separator := make([]string, len(header))
+	for i := range separator {
+		separator[i] = "---"
+	}
+
+	var table strings.Builder
+	table.WriteString(formatLine(header))
+	table.WriteString(formatLine(separator))
+	for i, entry := range entries {
+		if len(entry) != len(header) {
+			return errors.Wrapf(ErrInvalidFormat, "row %d has %d columns, expected %d", i, len(entry), len(header))
+		}
+		table.WriteString(formatLine(entry))
+	}
+
+	_, err := io.WriteString(out, table.String())
+	return err
+}
+
+// WriteSorted writes into out the tabulated entries stably sorted by the column at index sortCol
+func WriteSorted(out io.Writer, sortCol int, header []string, entries ...[]string) error {
+	if sortCol < 0 || sortCol >= len(header) {
+		return errors.Wrapf(ErrInvalidFormat, "sort column %d out of range", sortCol)
+	}
+
+	sorted := make([][]string, len(entries))
+	copy(sorted, entries)
+	if err := SortEntries(sortCol, sorted); err != nil {
+		return err
+	}
+	return Write(out, header, sorted...)
+}
+
+// SortEntries stably sorts the entries by the column at index col
+func SortEntries(col int, entries [][]string) error {
+	for i, entry := range entries {
+		if col < 0 || col >= len(entry) {
+			return errors.Wrapf(ErrInvalidFormat, "entry %d has no column %d", i, col)
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return Less(entries[i][col], entries[j][col])
+	})
+	return nil
+}
+
+// Less reports whether the cell a sorts before the cell b, cells are compared
+// numerically when both of them are numbers and lexically otherwise
+func Less(a, b string) bool {
+	na, okA := new(big.Float).SetString(a)
+	nb, okB := new(big.Float).SetString(b)
+	if okA && okB {
+		return na.Cmp(nb) < 0
+	}
+	return a < b
+}
+
+const (
+	ellipsis = "..."
+
+	// tabWidth is the width of the tab stops the table columns are aligned on
+	tabWidth = 8
+
+	// minColumnWidth is the minimum width FitColumnWidth truncates the cells to
+	minColumnWidth = 16
+)
+
+// Truncate returns the entries with the cells longer than maxWidth shortened with an ellipsis
+// in their middle, e.g. cosmos1abc...xyz, the entries are returned unchanged if maxWidth is 0
+func Truncate(maxWidth int, entries ...[]string) [][]string {
+	if maxWidth <= 0 {
+		return entries
+	}
+	truncated := make([][]string, 0, len(entries))
+	for _, entry := range entries {
+		cells := make([]string, 0, len(entry))
+		for _, cell := range entry {
+			cells = append(cells, TruncateCell(cell, maxWidth))
+		}
+		truncated = append(truncated, cells)
+	}
+	return truncated
+}
+
+// TruncateCell shortens the cell with an ellipsis in its middle if it is longer than maxWidth
+func TruncateCell(cell string, maxWidth int) string {
+	runes := []rune(cell)
+	if maxWidth <= 0 || len(runes) <= maxWidth {
+		return cell
+	}
+	if maxWidth <= len(ellipsis) {
+		return string(runes[:maxWidth])
+	}
+	keep := maxWidth - len(ellipsis)
+	head, tail := (keep+1)/2, keep/2
+	return string(runes[:head]) + ellipsis + string(runes[len(runes)-tail:])
+}
+
+// FitColumnWidth returns the maximum width of the cells for the table of the header and entries
+// to fit in width, 0 if the table already fits. The cells are never truncated below 16 characters
+func FitColumnWidth(width int, header []string, entries ...[]string) int {
+	if width <= 0 || len(header) == 0 {
+		return 0
+	}
+
+	columns := make([]int, len(header))
+	for _, line := range append([][]string{header}, entries...) {
+		for i, cell := range line {
+			if i < len(columns) && len([]rune(cell)) > columns[i] {
+				columns[i] = len([]rune(cell))
+			}
+		}
+	}
+
+	// each cell is followed by a space and padded to the next tab stop
+	var tableWidth int
+	for _, column := range columns {
+		tableWidth += (column/tabWidth + 1) * tabWidth
+	}
+	if tableWidth <= width {
+		return 0
+	}
+
+	maxWidth := width/len(header) - 1
+	if maxWidth < minColumnWidth {
+		maxWidth = minColumnWidth
+	}
+	return maxWidth
+}
@@ -3,6 +3,7 @@ package entrywriter_test
 import (
 	"errors"
 	"io"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -33,7 +34,189 @@ func TestWrite(t *testing.T) {
 	entries[0] = []string{"foo", "bar"}
 	err = entrywriter.Write(io.Discard, header, entries...)
 	require.ErrorIs(t, err, entrywriter.ErrInvalidFormat, "should prevent entry length mismatch")
+	require.Contains(t, err.Error(), "row 0 has 2 columns, expected 3")
 
 	var wErr WriterWithError
 	require.Error(t, entrywriter.Write(wErr, header, entries...), "should catch writer errors")
 }
+
+func TestWritePercent(t *testing.T) {
+	var out strings.Builder
+	require.NoError(t, entrywriter.Write(&out, []string{"validator", "power"}, []string{"spn1foo", "25.00%"}))
+	require.Contains(t, out.String(), "25.00%")
+	require.NotContains(t, out.String(), "MISSING")
+}
+
+func TestWriteCSV(t *testing.T) {
+	header := []string{"Genesis Account", "Coins"}
+
+	entries := [][]string{
+		{"spn1foo", "1000stake,2000token"},
+		{"spn1bar", "500stake"},
+		{"spn1\"quoted\"", "multi\nline"},
+	}
+
+	var out strings.Builder
+	require.NoError(t, entrywriter.WriteCSV(&out, header, entries...))
+	require.Equal(t, `Genesis Account,Coins
+spn1foo,"1000stake,2000token"
+spn1bar,500stake
+"spn1""quoted""","multi
+line"
+`, out.String())
+
+	require.NoError(t, entrywriter.WriteCSV(io.Discard, header), "should allow no entry")
+
+	err := entrywriter.WriteCSV(io.Discard, []string{})
+	require.ErrorIs(t, err, entrywriter.ErrInvalidFormat, "should prevent no header")
+
+	err = entrywriter.WriteCSV(io.Discard, header, []string{"spn1foo"})
+	require.ErrorIs(t, err, entrywriter.ErrInvalidFormat, "should prevent entry length mismatch")
+
+	var wErr WriterWithError
+	require.Error(t, entrywriter.WriteCSV(wErr, header, entries...), "should catch writer errors")
+}
+
+func TestCSVWriter(t *testing.T) {
+	header := []string{"Genesis Account", "Coins"}
+
+	var out strings.Builder
+	w, err := entrywriter.NewCSVWriter(&out, false, header)
+	require.NoError(t, err)
+	require.NoError(t, w.Write([]string{"spn1foo", "1000stake,2000token"}))
+	require.NoError(t, w.Write([]string{"spn1bar", "500stake"}))
+	require.NoError(t, w.Flush())
+	require.Equal(t, `spn1foo,"1000stake,2000token"
+spn1bar,500stake
+`, out.String())
+
+	_, err = entrywriter.NewCSVWriter(io.Discard, true, []string{})
+	require.ErrorIs(t, err, entrywriter.ErrInvalidFormat, "should prevent no header")
+
+	w, err = entrywriter.NewCSVWriter(io.Discard, true, header)
+	require.NoError(t, err)
+	require.ErrorIs(t, w.Write([]string{"spn1foo"}), entrywriter.ErrInvalidFormat, "should prevent entry length mismatch")
+}
+
+func TestWriteNoHeader(t *testing.T) {
+	header := []string{"name", "amount"}
+	entries := [][]string{
+		{"foo", "100"},
+		{"bar", "20"},
+	}
+
+	var out strings.Builder
+	require.NoError(t, entrywriter.WriteNoHeader(&out, header, entries...))
+	require.Equal(t, "foo \t100 \t\nbar \t20 \t\n\n", out.String())
+
+	out.Reset()
+	require.NoError(t, entrywriter.WriteCSVNoHeader(&out, header, entries...))
+	require.Equal(t, "foo,100\nbar,20\n", out.String())
+
+	err := entrywriter.WriteNoHeader(io.Discard, header, []string{"foo"})
+	require.ErrorIs(t, err, entrywriter.ErrInvalidFormat, "should prevent entry length mismatch")
+
+	err = entrywriter.WriteCSVNoHeader(io.Discard, []string{})
+	require.ErrorIs(t, err, entrywriter.ErrInvalidFormat, "should prevent no header")
+}
+
+func TestWriteMarkdown(t *testing.T) {
+	header := []string{"name", "amount"}
+	entries := [][]string{
+		{"foo", "100"},
+		{"a|b", "20"},
+	}
+
+	var out strings.Builder
+	require.NoError(t, entrywriter.WriteMarkdown(&out, header, entries...))
+	require.Equal(t, `| name | amount |
+| --- | --- |
+| foo | 100 |
+| a\|b | 20 |
+`, out.String())
+
+	err := entrywriter.WriteMarkdown(io.Discard, header, []string{"foo"})
+	require.ErrorIs(t, err, entrywriter.ErrInvalidFormat, "should prevent entry length mismatch")
+
+	err = entrywriter.WriteMarkdown(io.Discard, []string{})
+	require.ErrorIs(t, err, entrywriter.ErrInvalidFormat, "should prevent no header")
+
+	var wErr WriterWithError
+	require.Error(t, entrywriter.WriteMarkdown(wErr, header, entries...), "should catch writer errors")
+}
+
+func TestWriteSorted(t *testing.T) {
+	header := []string{"name", "amount"}
+
+	entries := [][]string{
+		{"foo", "100"},
+		{"bar", "20"},
+		{"foobar", "3"},
+		{"baz", "20"},
+	}
+
+	var out strings.Builder
+	require.NoError(t, entrywriter.WriteSorted(&out, 1, header, entries...))
+	require.Equal(t, []string{"foo", "100"}, entries[0], "should not sort the provided entries")
+
+	var expected strings.Builder
+	require.NoError(t, entrywriter.Write(&expected, header,
+		[]string{"foobar", "3"},
+		[]string{"bar", "20"},
+		[]string{"baz", "20"},
+		[]string{"foo", "100"},
+	))
+	require.Equal(t, expected.String(), out.String(), "should sort numerically and stably")
+
+	err := entrywriter.WriteSorted(io.Discard, 2, header, entries...)
+	require.ErrorIs(t, err, entrywriter.ErrInvalidFormat, "should prevent out of range column")
+}
+
+func TestLess(t *testing.T) {
+	require.True(t, entrywriter.Less("9", "10"))
+	require.True(t, entrywriter.Less("1.5", "2"))
+	require.False(t, entrywriter.Less("10", "9"))
+	require.True(t, entrywriter.Less("10stake", "9stake"), "should compare non numbers lexically")
+	require.True(t, entrywriter.Less("bar", "foo"))
+}
+
+func TestTruncateCell(t *testing.T) {
+	tests := []struct {
+		name     string
+		cell     string
+		maxWidth int
+		want     string
+	}{
+		{name: "no truncation", cell: "cosmos1dd246yq6z5vzjz9gh8cff46pll75yyl8ygndsj", maxWidth: 0, want: "cosmos1dd246yq6z5vzjz9gh8cff46pll75yyl8ygndsj"},
+		{name: "short cell", cell: "10stake", maxWidth: 16, want: "10stake"},
+		{name: "long cell", cell: "cosmos1dd246yq6z5vzjz9gh8cff46pll75yyl8ygndsj", maxWidth: 16, want: "cosmos1...ygndsj"},
+		{name: "width below the ellipsis", cell: "cosmos1dd246yq6z5", maxWidth: 2, want: "co"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := entrywriter.TruncateCell(tt.cell, tt.maxWidth)
+			require.Equal(t, tt.want, got)
+			if tt.maxWidth > 0 {
+				require.LessOrEqual(t, len(got), tt.maxWidth)
+			}
+		})
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	entries := [][]string{{"cosmos1dd246yq6z5vzjz9gh8cff46pll75yyl8ygndsj", "10stake"}}
+
+	require.Equal(t, entries, entrywriter.Truncate(0, entries...))
+	require.Equal(t, [][]string{{"cosmos1...ygndsj", "10stake"}}, entrywriter.Truncate(16, entries...))
+	require.Equal(t, "cosmos1dd246yq6z5vzjz9gh8cff46pll75yyl8ygndsj", entries[0][0], "should not modify the entries")
+}
+
+func TestFitColumnWidth(t *testing.T) {
+	header := []string{"Address", "Coins"}
+	entries := [][]string{{"cosmos1dd246yq6z5vzjz9gh8cff46pll75yyl8ygndsj", "10stake"}}
+
+	require.Equal(t, 0, entrywriter.FitColumnWidth(0, header, entries...), "should not fit without width")
+	require.Equal(t, 0, entrywriter.FitColumnWidth(120, header, entries...), "should fit in a wide terminal")
+	require.Equal(t, 19, entrywriter.FitColumnWidth(40, header, entries...))
+	require.Equal(t, 16, entrywriter.FitColumnWidth(20, header, entries...), "should not go below the minimum width")
+}
@@ -0,0 +1,151 @@
+// Package jsonpath selects values from decoded JSON documents with a subset of the JSONPath syntax:
+// the root $, the members .name or ['name'], the array indexes [n] and the wildcards .* or [*].
+package jsonpath
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Path is a parsed JSONPath selector.
+type Path struct {
+	expr  string
+	steps []step
+}
+
+// step selects the member key, the element index, or every member or element if wildcard.
+type step struct {
+	key      string
+	index    int
+	isIndex  bool
+	wildcard bool
+}
+
+// Parse parses the JSONPath expression, it must start with the root $.
+func Parse(expr string) (Path, error) {
+	p := Path{expr: expr}
+	if !strings.HasPrefix(expr, "$") {
+		return Path{}, fmt.Errorf("invalid JSON path %s: it must start with $", expr)
+	}
+	rest := expr[1:]
+	for rest != "" {
+		var (
+			s   step
+			err error
+		)
+		switch rest[0] {
+		case '.':
+			s, rest, err = parseMember(rest[1:])
+		case '[':
+			s, rest, err = parseBracket(rest[1:])
+		default:
+			err = fmt.Errorf("unexpected %q", rest[0])
+		}
+		if err != nil {
+			return Path{}, fmt.Errorf("invalid JSON path %s: %s", expr, err)
+		}
+		p.steps = append(p.steps, s)
+	}
+	return p, nil
+}
+
+// parseMember parses the member name or wildcard following a dot.
+func parseMember(rest string) (step, string, error) {
+	end := strings.IndexAny(rest, ".[")
+	if end < 0 {
+		end = len(rest)
+	}
+	name := rest[:end]
+	switch name {
+	case "":
+		return step{}, "", fmt.Errorf("missing member name")
+	case "*":
+		return step{wildcard: true}, rest[end:], nil
+	}
+	return step{key: name}, rest[end:], nil
+}
+
+// parseBracket parses the quoted member name, index or wildcard following an opening bracket.
+func parseBracket(rest string) (step, string, error) {
+	if rest != "" && (rest[0] == '\'' || rest[0] == '"') {
+		quote := rest[0]
+		end := strings.IndexByte(rest[1:], quote)
+		if end < 0 || !strings.HasPrefix(rest[end+2:], "]") {
+			return step{}, "", fmt.Errorf("unterminated member name")
+		}
+		return step{key: rest[1 : end+1]}, rest[end+3:], nil
+	}
+
+	end := strings.IndexByte(rest, ']')
+	if end < 0 {
+		return step{}, "", fmt.Errorf("missing ]")
+	}
+	selector := rest[:end]
+	if selector == "*" {
+		return step{wildcard: true}, rest[end+1:], nil
+	}
+	index, err := strconv.Atoi(selector)
+	if err != nil {
+		return step{}, "", fmt.Errorf("invalid index %s", selector)
+	}
+	return step{index: index, isIndex: true}, rest[end+1:], nil
+}
+
+// String returns the JSONPath expression.
+func (p Path) String() string {
+	return p.expr
+}
+
+// Select returns the values of the document matched by the path. The document is a value decoded
+// by encoding/json. The members matched by a wildcard are selected in the order of their names
+// since the decoded objects don't keep the order of their members.
+func (p Path) Select(doc interface{}) []interface{} {
+	matches := []interface{}{doc}
+	for _, s := range p.steps {
+		var next []interface{}
+		for _, match := range matches {
+			next = append(next, s.selectFrom(match)...)
+		}
+		matches = next
+	}
+	return matches
+}
+
+// selectFrom returns the values of the value selected by the step, a negative index counts from the end.
+func (s step) selectFrom(value interface{}) []interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if s.wildcard {
+			keys := make([]string, 0, len(v))
+			for key := range v {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			values := make([]interface{}, 0, len(keys))
+			for _, key := range keys {
+				values = append(values, v[key])
+			}
+			return values
+		}
+		if member, ok := v[s.key]; ok && !s.isIndex {
+			return []interface{}{member}
+		}
+	case []interface{}:
+		if s.wildcard {
+			return v
+		}
+		if !s.isIndex {
+			return nil
+		}
+		index := s.index
+		if index < 0 {
+			index += len(v)
+		}
+		if index >= 0 && index < len(v) {
+			return []interface{}{v[index]}
+		}
+	}
+	return nil
+}
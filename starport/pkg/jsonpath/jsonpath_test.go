@@ -0,0 +1,61 @@
+package jsonpath_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/pkg/jsonpath"
+)
+
+func TestSelect(t *testing.T) {
+	var doc interface{}
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"ChainID": "mars-1",
+		"Coordinator": {"ID": 3, "Address": "spn1"},
+		"Validators": [{"Moniker": "a"}, {"Moniker": "b"}],
+		"odd key": true
+	}`), &doc))
+
+	tests := []struct {
+		name string
+		path string
+		want []interface{}
+	}{
+		{name: "root", path: "$", want: []interface{}{doc}},
+		{name: "member", path: "$.ChainID", want: []interface{}{"mars-1"}},
+		{name: "nested member", path: "$.Coordinator.Address", want: []interface{}{"spn1"}},
+		{name: "quoted member", path: "$['odd key']", want: []interface{}{true}},
+		{name: "index", path: "$.Validators[1].Moniker", want: []interface{}{"b"}},
+		{name: "negative index", path: "$.Validators[-2].Moniker", want: []interface{}{"a"}},
+		{name: "array wildcard", path: "$.Validators[*].Moniker", want: []interface{}{"a", "b"}},
+		{name: "member wildcard", path: "$.Coordinator.*", want: []interface{}{"spn1", float64(3)}},
+		{name: "missing member", path: "$.HomePath", want: nil},
+		{name: "index out of range", path: "$.Validators[2]", want: nil},
+		{name: "index of an object", path: "$.Coordinator[0]", want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path, err := jsonpath.Parse(tt.path)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, path.Select(doc))
+		})
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, path := range []string{
+		"ChainID",
+		"$.",
+		"$..ChainID",
+		"$[0",
+		"$[x]",
+		"$['ChainID]",
+		"$ChainID",
+	} {
+		t.Run(path, func(t *testing.T) {
+			_, err := jsonpath.Parse(path)
+			require.Error(t, err)
+		})
+	}
+}
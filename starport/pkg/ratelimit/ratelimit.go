@@ -0,0 +1,81 @@
+// Package ratelimit limits the rate at which readers are read with a token bucket.
+package ratelimit
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+)
+
+// Limiter is a token bucket refilled at a number of bytes per second that can hold up to
+// one second of bytes. The readers created from the same limiter share its rate.
+type Limiter struct {
+	mu     sync.Mutex
+	rate   int64
+	tokens float64
+	last   time.Time
+}
+
+// New returns a limiter allowing rate bytes per second, the bucket starts full.
+func New(rate int64) *Limiter {
+	return &Limiter{
+		rate:   rate,
+		tokens: float64(rate),
+		last:   time.Now(),
+	}
+}
+
+// Reader returns a reader reading r at the rate of the limiter,
+// the reads stop waiting for the bucket to refill when ctx is canceled.
+func (l *Limiter) Reader(ctx context.Context, r io.Reader) io.Reader {
+	return &reader{ctx: ctx, r: r, l: l}
+}
+
+// take removes n tokens from the bucket and returns how long to wait until the bucket isn't in debt.
+func (l *Limiter) take(n int) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * float64(l.rate)
+	if l.tokens > float64(l.rate) {
+		l.tokens = float64(l.rate)
+	}
+	l.last = now
+
+	l.tokens -= float64(n)
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / float64(l.rate) * float64(time.Second))
+}
+
+type reader struct {
+	ctx context.Context
+	r   io.Reader
+	l   *Limiter
+}
+
+// Read implements io.Reader, it reads at most one second of bytes at once
+// and waits after the read until the bytes are paid for.
+func (r *reader) Read(p []byte) (int, error) {
+	if int64(len(p)) > r.l.rate {
+		p = p[:r.l.rate]
+	}
+	n, err := r.r.Read(p)
+	if n == 0 {
+		return n, err
+	}
+
+	if wait := r.l.take(n); wait > 0 {
+		t := time.NewTimer(wait)
+		defer t.Stop()
+		select {
+		case <-r.ctx.Done():
+			return n, r.ctx.Err()
+		case <-t.C:
+		}
+	}
+	return n, err
+}
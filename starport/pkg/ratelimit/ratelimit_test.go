@@ -0,0 +1,33 @@
+package ratelimit_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/pkg/ratelimit"
+)
+
+func TestReader(t *testing.T) {
+	data := bytes.Repeat([]byte("a"), 15000)
+
+	// the first second of bytes is read at once, the remaining half second is rate limited
+	start := time.Now()
+	r := ratelimit.New(10000).Reader(context.Background(), bytes.NewReader(data))
+	got, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.Equal(t, data, got)
+	require.GreaterOrEqual(t, time.Since(start), 400*time.Millisecond)
+}
+
+func TestReaderCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	r := ratelimit.New(10).Reader(ctx, bytes.NewReader(bytes.Repeat([]byte("a"), 100)))
+	_, err := io.ReadAll(r)
+	require.ErrorIs(t, err, context.Canceled)
+}
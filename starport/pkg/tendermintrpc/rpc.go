@@ -3,6 +3,7 @@ package tendermintrpc
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -14,16 +15,36 @@ const (
 	endpointNetInfo = "/net_info"
 	endpointGenesis = "/genesis"
 	endpointStatus  = "/status"
+
+	endpointGenesisChunked = "/genesis_chunked"
 )
 
 // Client is a Tendermint RPC client.
 type Client struct {
-	addr string
+	addr   string
+	client *http.Client
+}
+
+// Option configures the Tendermint RPC client.
+type Option func(*Client)
+
+// WithHTTPClient sets the HTTP client used to send the requests.
+func WithHTTPClient(client *http.Client) Option {
+	return func(c *Client) {
+		c.client = client
+	}
 }
 
 // New creates a new Tendermint RPC client.
-func New(addr string) Client {
-	return Client{addr: addr}
+func New(addr string, options ...Option) Client {
+	c := Client{
+		addr:   addr,
+		client: http.DefaultClient,
+	}
+	for _, apply := range options {
+		apply(&c)
+	}
+	return c
 }
 
 // NetInfo represents Network Info.
@@ -35,13 +56,18 @@ func (c Client) url(endpoint string) string {
 	return fmt.Sprintf("%s%s", c.addr, endpoint)
 }
 
+// GenesisURL returns the URL of the endpoint serving the genesis of the node.
+func (c Client) GenesisURL() string {
+	return c.url(endpointGenesis)
+}
+
 // GetNetInfo retrieves network info.
 func (c Client) GetNetInfo(ctx context.Context) (NetInfo, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url(endpointNetInfo), nil)
 	if err != nil {
 		return NetInfo{}, err
 	}
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := c.client.Do(req)
 	if err != nil {
 		return NetInfo{}, err
 	}
@@ -78,7 +104,7 @@ func (c Client) GetGenesis(ctx context.Context) (Genesis, error) {
 		return Genesis{}, err
 	}
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := c.client.Do(req)
 	if err != nil {
 		return Genesis{}, err
 	}
@@ -113,7 +139,7 @@ func (c Client) Status(ctx context.Context) (NodeInfo, error) {
 		return NodeInfo{}, err
 	}
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := c.client.Do(req)
 	if err != nil {
 		return NodeInfo{}, err
 	}
@@ -158,3 +184,107 @@ func (c Client) Status(ctx context.Context) (NodeInfo, error) {
 
 	return info, nil
 }
+
+// SyncInfo holds the sync state of a node.
+type SyncInfo struct {
+	LatestBlockHeight int64
+	CatchingUp        bool
+}
+
+// GetSyncInfo retrieves the sync state of the node from its status.
+func (c Client) GetSyncInfo(ctx context.Context) (SyncInfo, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url(endpointStatus), nil)
+	if err != nil {
+		return SyncInfo{}, err
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return SyncInfo{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return SyncInfo{}, fmt.Errorf("%d", resp.StatusCode)
+	}
+
+	var out struct {
+		Result struct {
+			SyncInfo struct {
+				LatestBlockHeight string `json:"latest_block_height"`
+				CatchingUp        bool   `json:"catching_up"`
+			} `json:"sync_info"`
+		} `json:"result"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return SyncInfo{}, err
+	}
+
+	height, err := strconv.ParseInt(out.Result.SyncInfo.LatestBlockHeight, 10, 64)
+	if err != nil {
+		return SyncInfo{}, err
+	}
+
+	return SyncInfo{
+		LatestBlockHeight: height,
+		CatchingUp:        out.Result.SyncInfo.CatchingUp,
+	}, nil
+}
+
+// GetRawGenesis retrieves the full genesis document of the node.
+// The genesis is reassembled from its chunks when the node can't serve it at once,
+// which happens for large genesis files.
+func (c Client) GetRawGenesis(ctx context.Context) ([]byte, error) {
+	var out struct {
+		Result struct {
+			Genesis json.RawMessage `json:"genesis"`
+		} `json:"result"`
+	}
+	err := c.get(ctx, c.url(endpointGenesis), &out)
+	if err == nil && len(out.Result.Genesis) > 0 {
+		return out.Result.Genesis, nil
+	}
+
+	var genesis []byte
+	for chunk, total := 0, 1; chunk < total; chunk++ {
+		var out struct {
+			Result struct {
+				Total string `json:"total"`
+				Data  string `json:"data"`
+			} `json:"result"`
+		}
+		if err := c.get(ctx, fmt.Sprintf("%s?chunk=%d", c.url(endpointGenesisChunked), chunk), &out); err != nil {
+			return nil, err
+		}
+
+		if total, err = strconv.Atoi(out.Result.Total); err != nil {
+			return nil, err
+		}
+		data, err := base64.StdEncoding.DecodeString(out.Result.Data)
+		if err != nil {
+			return nil, err
+		}
+		genesis = append(genesis, data...)
+	}
+	return genesis, nil
+}
+
+func (c Client) get(ctx context.Context, url string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%d", resp.StatusCode)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
@@ -3,12 +3,20 @@ package yaml
 import (
 	"context"
 	"errors"
+	"fmt"
+	"reflect"
 	"strings"
 
+	"github.com/fatih/color"
 	"github.com/goccy/go-yaml"
+	"github.com/goccy/go-yaml/lexer"
 	"github.com/goccy/go-yaml/parser"
+	"github.com/goccy/go-yaml/printer"
 )
 
+// RedactedValue replaces the values of the redacted fields.
+const RedactedValue = "***"
+
 // Marshal converts an object to a string in a YAML format and transforms
 // the byte slice fields from the path to string to be more readable.
 func Marshal(ctx context.Context, obj interface{}, paths ...string) (string, error) {
@@ -41,3 +49,90 @@ func Marshal(ctx context.Context, obj interface{}, paths ...string) (string, err
 
 	return file.String(), nil
 }
+
+// MarshalColor works like Marshal and colors the keys, strings and numbers of the YAML output.
+// Colors are omitted when they are disabled, e.g. with NO_COLOR or when stdout is not a terminal.
+func MarshalColor(ctx context.Context, obj interface{}, paths ...string) (string, error) {
+	out, err := Marshal(ctx, obj, paths...)
+	if err != nil || color.NoColor {
+		return out, err
+	}
+	return Colorize(out), nil
+}
+
+// FromJSON converts a JSON document to YAML, the order of the object keys is preserved.
+func FromJSON(doc []byte) (string, error) {
+	out, err := yaml.JSONToYAML(doc)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// Colorize colors the keys, strings and numbers of a YAML document.
+func Colorize(doc string) string {
+	p := printer.Printer{
+		MapKey: colorProperty(color.FgCyan),
+		String: colorProperty(color.FgGreen),
+		Number: colorProperty(color.FgMagenta),
+		Bool:   colorProperty(color.FgYellow),
+	}
+	return p.PrintTokens(lexer.Tokenize(doc))
+}
+
+func colorProperty(attr color.Attribute) printer.PrintFunc {
+	return func() *printer.Property {
+		return &printer.Property{
+			Prefix: fmt.Sprintf("\x1b[%dm", attr),
+			Suffix: fmt.Sprintf("\x1b[%dm", color.Reset),
+		}
+	}
+}
+
+// Redact returns a copy of obj where the string fields tagged with the redact option,
+// e.g. `yaml:"home,redact"`, are replaced by RedactedValue. Nested structs are redacted too.
+func Redact(obj interface{}) interface{} {
+	v := reflect.ValueOf(obj)
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return obj
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return obj
+	}
+
+	redacted := reflect.New(v.Type()).Elem()
+	redacted.Set(v)
+	redactStruct(redacted)
+	return redacted.Interface()
+}
+
+func redactStruct(v reflect.Value) {
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Field(i)
+		if !field.CanSet() {
+			continue
+		}
+
+		switch field.Kind() {
+		case reflect.String:
+			if isRedacted(v.Type().Field(i)) {
+				field.SetString(RedactedValue)
+			}
+		case reflect.Struct:
+			redactStruct(field)
+		}
+	}
+}
+
+func isRedacted(field reflect.StructField) bool {
+	options := strings.Split(field.Tag.Get("yaml"), ",")
+	for _, opt := range options[1:] {
+		if opt == "redact" {
+			return true
+		}
+	}
+	return false
+}
@@ -2,6 +2,7 @@ package yaml
 
 import (
 	"context"
+	"regexp"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -147,3 +148,69 @@ field3: field3`,
 		})
 	}
 }
+
+func TestColorize(t *testing.T) {
+	doc := `field1: field1
+field2: 2`
+
+	got := Colorize(doc)
+	require.Contains(t, got, "\x1b[36mfield1\x1b[0m", "should color keys")
+	require.Contains(t, got, "\x1b[35m 2\x1b[0m", "should color numbers")
+
+	escapes := regexp.MustCompile("\x1b\\[[0-9]+m")
+	require.Equal(t, doc, escapes.ReplaceAllString(got, ""), "should keep the document content")
+}
+
+func TestRedact(t *testing.T) {
+	type nested struct {
+		Secret string `yaml:"secret,redact"`
+		Public string `yaml:"public"`
+	}
+	type redactable struct {
+		Home   string `yaml:",redact"`
+		Name   string
+		Nested nested `yaml:"nested"`
+	}
+	obj := redactable{
+		Home: "/home/foo",
+		Name: "foo",
+		Nested: nested{
+			Secret: "bar",
+			Public: "baz",
+		},
+	}
+
+	got := Redact(obj)
+	require.Equal(t, redactable{
+		Home: RedactedValue,
+		Name: "foo",
+		Nested: nested{
+			Secret: RedactedValue,
+			Public: "baz",
+		},
+	}, got)
+	require.Equal(t, "/home/foo", obj.Home, "should not modify the original object")
+	require.Equal(t, got, Redact(&obj), "should redact pointers")
+	require.Equal(t, "foo", Redact("foo"), "should ignore non struct objects")
+
+	out, err := Marshal(context.Background(), got)
+	require.NoError(t, err)
+	require.Equal(t, `home: "***"
+name: foo
+nested:
+  secret: "***"
+  public: baz`, out)
+}
+
+func TestFromJSON(t *testing.T) {
+	out, err := FromJSON([]byte(`{"name":"foo","accounts":[{"address":"bar","coins":"10stake"}]}`))
+	require.NoError(t, err)
+	require.Equal(t, `name: foo
+accounts:
+- address: bar
+  coins: 10stake
+`, out)
+
+	_, err = FromJSON([]byte(`{"name":`))
+	require.Error(t, err)
+}
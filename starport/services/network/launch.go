@@ -2,6 +2,7 @@ package network
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -9,11 +10,19 @@ import (
 	"github.com/tendermint/starport/starport/pkg/events"
 	"github.com/tendermint/starport/starport/pkg/xtime"
 	"github.com/tendermint/starport/starport/services/network/networkchain"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// ErrLaunchParamsUnavailable is returned when SPN doesn't expose the launch params.
+var ErrLaunchParamsUnavailable = errors.New("launch params are not available on this SPN network")
+
 // LaunchParams fetches the chain launch module params from SPN
 func (n Network) LaunchParams(ctx context.Context) (launchtypes.Params, error) {
 	res, err := launchtypes.NewQueryClient(n.cosmos.Context).Params(ctx, &launchtypes.QueryParamsRequest{})
+	if status.Code(err) == codes.Unimplemented {
+		return launchtypes.Params{}, ErrLaunchParamsUnavailable
+	}
 	if err != nil {
 		return launchtypes.Params{}, err
 	}
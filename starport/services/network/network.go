@@ -8,6 +8,8 @@ import (
 	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
 	"github.com/tendermint/starport/starport/pkg/cosmosclient"
 	"github.com/tendermint/starport/starport/pkg/events"
+	"github.com/tendermint/starport/starport/pkg/tendermintlogger"
+	tmlog "github.com/tendermint/tendermint/libs/log"
 )
 
 // Network is network builder.
@@ -15,6 +17,7 @@ type Network struct {
 	ev      events.Bus
 	cosmos  cosmosclient.Client
 	account cosmosaccount.Account
+	logger  tmlog.Logger
 }
 
 type Chain interface {
@@ -37,11 +40,20 @@ func CollectEvents(ev events.Bus) Option {
 	}
 }
 
+// WithLogger logs the queries sent to SPN, the failed queries are logged at the error level
+// and the method, arguments and response size of each query at the debug level.
+func WithLogger(logger tmlog.Logger) Option {
+	return func(b *Network) {
+		b.logger = logger
+	}
+}
+
 // New creates a Builder.
 func New(cosmos cosmosclient.Client, account cosmosaccount.Account, options ...Option) (Network, error) {
 	n := Network{
 		cosmos:  cosmos,
 		account: account,
+		logger:  tendermintlogger.DiscardLogger{},
 	}
 	for _, opt := range options {
 		opt(&n)
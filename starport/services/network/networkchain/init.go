@@ -94,11 +94,11 @@ func (c *Chain) initGenesis(ctx context.Context) error {
 	}
 
 	// check the genesis is valid
-	return c.checkGenesis(ctx)
+	return c.ValidateGenesis(ctx)
 }
 
-// checkGenesis checks the stored genesis is valid
-func (c *Chain) checkGenesis(ctx context.Context) error {
+// ValidateGenesis checks the stored genesis is valid with the validate-genesis command of the chain
+func (c *Chain) ValidateGenesis(ctx context.Context) error {
 	// perform static analysis of the chain with the validate-genesis command.
 	chainCmd, err := c.chain.Commands(ctx)
 	if err != nil {
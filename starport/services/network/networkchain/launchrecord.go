@@ -0,0 +1,32 @@
+package networkchain
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/tendermint/starport/starport/services/network/networktypes"
+)
+
+// launchRecordFile is the file caching the launch record in the chain home.
+const launchRecordFile = "launch.json"
+
+// SaveLaunchRecord caches the launch record in the chain home so the launch can be shown offline.
+func SaveLaunchRecord(home string, launch networktypes.ChainLaunch) error {
+	data, err := json.MarshalIndent(launch, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(home, launchRecordFile), data, 0644)
+}
+
+// LoadLaunchRecord returns the launch record cached in the chain home,
+// the error satisfies os.IsNotExist if no launch record is cached.
+func LoadLaunchRecord(home string) (launch networktypes.ChainLaunch, err error) {
+	data, err := os.ReadFile(filepath.Join(home, launchRecordFile))
+	if err != nil {
+		return launch, err
+	}
+	err = json.Unmarshal(data, &launch)
+	return launch, err
+}
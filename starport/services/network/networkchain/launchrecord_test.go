@@ -0,0 +1,30 @@
+package networkchain_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/services/network/networkchain"
+	"github.com/tendermint/starport/starport/services/network/networktypes"
+)
+
+func TestLaunchRecord(t *testing.T) {
+	home := t.TempDir()
+
+	_, err := networkchain.LoadLaunchRecord(home)
+	require.True(t, os.IsNotExist(err))
+
+	launch := networktypes.ChainLaunch{
+		ID:            1,
+		ChainID:       "mars-1",
+		SourceURL:     "https://github.com/foo/mars",
+		SourceHash:    "abc",
+		CoordinatorID: 2,
+	}
+	require.NoError(t, networkchain.SaveLaunchRecord(home, launch))
+
+	got, err := networkchain.LoadLaunchRecord(home)
+	require.NoError(t, err)
+	require.Equal(t, launch, got)
+}
@@ -129,13 +129,7 @@ func CollectEvents(ev events.Bus) Option {
 
 // New initializes a network blockchain from source and options.
 func New(ctx context.Context, ar cosmosaccount.Registry, source SourceOption, options ...Option) (*Chain, error) {
-	c := &Chain{
-		ar: ar,
-	}
-	for _, apply := range options {
-		apply(c)
-	}
-	source(c)
+	c := newChain(ar, source, options...)
 
 	c.ev.Send(events.New(events.StatusOngoing, "Fetching the source code"))
 
@@ -176,6 +170,19 @@ func New(ctx context.Context, ar cosmosaccount.Registry, source SourceOption, op
 	return c, nil
 }
 
+// newChain creates a chain from its source and options. Options are applied after the source
+// so they take precedence over the values provided by the source, e.g. the home of a launch.
+func newChain(ar cosmosaccount.Registry, source SourceOption, options ...Option) *Chain {
+	c := &Chain{
+		ar: ar,
+	}
+	source(c)
+	for _, apply := range options {
+		apply(c)
+	}
+	return c
+}
+
 func (c Chain) ID() (string, error) {
 	return c.chain.ID()
 }
@@ -208,6 +215,19 @@ func (c Chain) SourceHash() string {
 	return c.hash
 }
 
+// CurrentSourceHash returns the hash of the commit checked out in the source of the chain.
+func (c Chain) CurrentSourceHash() (string, error) {
+	repo, err := git.PlainOpen(c.path)
+	if err != nil {
+		return "", err
+	}
+	ref, err := repo.Head()
+	if err != nil {
+		return "", err
+	}
+	return ref.Hash().String(), nil
+}
+
 func (c Chain) IsHomeDirExist() (ok bool, err error) {
 	home, err := c.chain.Home()
 	if err != nil {
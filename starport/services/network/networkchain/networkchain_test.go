@@ -0,0 +1,23 @@
+package networkchain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
+	"github.com/tendermint/starport/starport/services/network/networktypes"
+)
+
+func TestNewChainHome(t *testing.T) {
+	launch := networktypes.ChainLaunch{
+		ID:      1,
+		ChainID: "foo-1",
+	}
+
+	c := newChain(cosmosaccount.Registry{}, SourceLaunch(launch))
+	require.Equal(t, ChainHome(1), c.home, "should use the launch home by default")
+
+	home := t.TempDir()
+	c = newChain(cosmosaccount.Registry{}, SourceLaunch(launch), WithHome(home))
+	require.Equal(t, home, c.home, "should use the provided home")
+}
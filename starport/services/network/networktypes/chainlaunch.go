@@ -4,14 +4,16 @@ import launchtypes "github.com/tendermint/spn/x/launch/types"
 
 // ChainLaunch represents the launch of a chain on SPN
 type ChainLaunch struct {
-	ID          uint64
-	ChainID     string
-	SourceURL   string
-	SourceHash  string
-	GenesisURL  string
-	GenesisHash string
-	LaunchTime  int64
-	CampaignID  uint64
+	ID              uint64
+	ChainID         string
+	SourceURL       string
+	SourceHash      string
+	GenesisURL      string
+	GenesisHash     string
+	LaunchTime      int64
+	LaunchTriggered bool
+	CampaignID      uint64
+	CoordinatorID   uint64
 }
 
 // ToChainLaunch converts a chain launch data from SPN and returns a ChainLaunch object
@@ -22,12 +24,14 @@ func ToChainLaunch(chain launchtypes.Chain) ChainLaunch {
 	}
 
 	launch := ChainLaunch{
-		ID:         chain.LaunchID,
-		ChainID:    chain.GenesisChainID,
-		SourceURL:  chain.SourceURL,
-		SourceHash: chain.SourceHash,
-		LaunchTime: launchTime,
-		CampaignID: chain.CampaignID,
+		ID:              chain.LaunchID,
+		ChainID:         chain.GenesisChainID,
+		SourceURL:       chain.SourceURL,
+		SourceHash:      chain.SourceHash,
+		LaunchTime:      launchTime,
+		LaunchTriggered: chain.LaunchTriggered,
+		CampaignID:      chain.CampaignID,
+		CoordinatorID:   chain.CoordinatorID,
 	}
 
 	// check if custom genesis URL is provided.
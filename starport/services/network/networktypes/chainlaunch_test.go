@@ -39,6 +39,7 @@ func TestToChainLaunch(t *testing.T) {
 			name: "launched chain with custom genesis url and no campaign",
 			fetched: launchtypes.Chain{
 				LaunchID:        1,
+				CoordinatorID:   2,
 				GenesisChainID:  "bar-1",
 				SourceURL:       "bar.com",
 				SourceHash:      "0xbbb",
@@ -50,14 +51,16 @@ func TestToChainLaunch(t *testing.T) {
 				),
 			},
 			expected: networktypes.ChainLaunch{
-				ID:          1,
-				ChainID:     "bar-1",
-				SourceURL:   "bar.com",
-				SourceHash:  "0xbbb",
-				GenesisURL:  "genesisfoo.com",
-				GenesisHash: "0xccc",
-				LaunchTime:  100,
-				CampaignID:  0,
+				ID:              1,
+				ChainID:         "bar-1",
+				SourceURL:       "bar.com",
+				SourceHash:      "0xbbb",
+				GenesisURL:      "genesisfoo.com",
+				GenesisHash:     "0xccc",
+				LaunchTime:      100,
+				LaunchTriggered: true,
+				CampaignID:      0,
+				CoordinatorID:   2,
 			},
 		},
 	}
@@ -0,0 +1,25 @@
+package networktypes
+
+import profiletypes "github.com/tendermint/spn/x/profile/types"
+
+// Coordinator represents the profile of a coordinator on SPN with the launches it coordinates
+type Coordinator struct {
+	ID        uint64
+	Address   string
+	Identity  string
+	Website   string
+	Details   string
+	LaunchIDs []uint64
+}
+
+// ToCoordinator converts a coordinator data from SPN and returns a Coordinator object,
+// the launch IDs are left empty since they are not part of the coordinator data
+func ToCoordinator(coord profiletypes.Coordinator) Coordinator {
+	return Coordinator{
+		ID:       coord.CoordinatorID,
+		Address:  coord.Address,
+		Identity: coord.Description.Identity,
+		Website:  coord.Description.Website,
+		Details:  coord.Description.Details,
+	}
+}
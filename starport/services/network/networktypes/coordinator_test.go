@@ -0,0 +1,28 @@
+package networktypes_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+	"github.com/tendermint/starport/starport/services/network/networktypes"
+)
+
+func TestToCoordinator(t *testing.T) {
+	got := networktypes.ToCoordinator(profiletypes.Coordinator{
+		CoordinatorID: 1,
+		Address:       "spn1foo",
+		Description: profiletypes.CoordinatorDescription{
+			Identity: "foo",
+			Website:  "foo.com",
+			Details:  "foo details",
+		},
+	})
+	require.Equal(t, networktypes.Coordinator{
+		ID:       1,
+		Address:  "spn1foo",
+		Identity: "foo",
+		Website:  "foo.com",
+		Details:  "foo details",
+	}, got)
+}
@@ -15,8 +15,8 @@ type GenesisInformation struct {
 
 // GenesisAccount represents an account with initial coin allocation for the chain for the chain genesis
 type GenesisAccount struct {
-	Address string
-	Coins   string
+	Address string `json:"address"`
+	Coins   string `json:"coins"`
 }
 
 // VestingAccount represents a vesting account with initial coin allocation  and vesting option for the chain genesis
@@ -30,8 +30,10 @@ type VestingAccount struct {
 
 // GenesisValidator represents a genesis validator associated with a gentx in the chain genesis
 type GenesisValidator struct {
-	Gentx []byte
-	Peer  string
+	Address        string
+	SelfDelegation string
+	Gentx          []byte
+	Peer           string
 }
 
 // NewGenesisInformation initializes a new GenesisInformation
@@ -73,7 +75,9 @@ func ToVestingAccount(acc launchtypes.VestingAccount) (VestingAccount, error) {
 // ToGenesisValidator converts genesis validator from SPN
 func ToGenesisValidator(val launchtypes.GenesisValidator) GenesisValidator {
 	return GenesisValidator{
-		Gentx: val.GenTx,
-		Peer:  val.Peer,
+		Address:        val.Address,
+		SelfDelegation: val.SelfDelegation.String(),
+		Gentx:          val.GenTx,
+		Peer:           val.Peer,
 	}
 }
@@ -96,12 +96,16 @@ func TestToGenesisValidator(t *testing.T) {
 		{
 			name: "genesis validator",
 			fetched: launchtypes.GenesisValidator{
-				GenTx: []byte("abc"),
-				Peer:  "abc@0.0.0.0",
+				Address:        "spn123",
+				GenTx:          []byte("abc"),
+				SelfDelegation: sdk.NewCoin("foo", sdk.NewInt(1000)),
+				Peer:           "abc@0.0.0.0",
 			},
 			expected: networktypes.GenesisValidator{
-				Gentx: []byte("abc"),
-				Peer:  "abc@0.0.0.0",
+				Address:        "spn123",
+				SelfDelegation: "1000foo",
+				Gentx:          []byte("abc"),
+				Peer:           "abc@0.0.0.0",
 			},
 		},
 	}
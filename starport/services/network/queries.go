@@ -2,13 +2,102 @@ package network
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"strings"
 
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/pkg/errors"
 	launchtypes "github.com/tendermint/spn/x/launch/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
 	"github.com/tendermint/starport/starport/pkg/events"
+	"github.com/tendermint/starport/starport/services/network/networkchain"
 	"github.com/tendermint/starport/starport/services/network/networktypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// ErrNotCoordinator is returned when the account is not registered as a coordinator on SPN.
+var ErrNotCoordinator = errors.New("the account is not a coordinator")
+
+// LaunchNotFoundError is returned when the launch doesn't exist on SPN.
+type LaunchNotFoundError struct {
+	LaunchID uint64
+}
+
+// Error implements error.
+func (e LaunchNotFoundError) Error() string {
+	return fmt.Sprintf("launch id %d not found on network", e.LaunchID)
+}
+
+// CoordinatorNotFoundError is returned when the coordinator doesn't exist on SPN,
+// the coordinator is either looked up by its ID or by its address.
+type CoordinatorNotFoundError struct {
+	CoordinatorID uint64
+	Address       string
+}
+
+// Error implements error.
+func (e CoordinatorNotFoundError) Error() string {
+	if e.Address != "" {
+		return fmt.Sprintf("coordinator %s not found on network", e.Address)
+	}
+	return fmt.Sprintf("coordinator id %d not found on network", e.CoordinatorID)
+}
+
+// GenesisSectionError is the error of the query of a section of the genesis information.
+type GenesisSectionError struct {
+	Section string
+	Err     error
+}
+
+// GenesisInformationError is returned along with the genesis information when some of its sections
+// can't be queried, the sections queried successfully are set in the genesis information.
+type GenesisInformationError []GenesisSectionError
+
+// Error implements error.
+func (e GenesisInformationError) Error() string {
+	errs := make([]string, 0, len(e))
+	for _, sectionErr := range e {
+		errs = append(errs, fmt.Sprintf("error querying %s: %s", sectionErr.Section, sectionErr.Err))
+	}
+	return strings.Join(errs, "; ")
+}
+
+// Cause returns the error of the first section that failed.
+func (e GenesisInformationError) Cause() error {
+	return e[0].Err
+}
+
+// ChainIDNotFoundError is returned when no launch on SPN has the chain ID.
+type ChainIDNotFoundError struct {
+	ChainID string
+}
+
+// Error implements error.
+func (e ChainIDNotFoundError) Error() string {
+	return fmt.Sprintf("no launch found with chain id %s on network", e.ChainID)
+}
+
+// AmbiguousChainIDError is returned when several launches on SPN have the chain ID.
+type AmbiguousChainIDError struct {
+	ChainID   string
+	LaunchIDs []uint64
+}
+
+// Error implements error.
+func (e AmbiguousChainIDError) Error() string {
+	ids := make([]string, len(e.LaunchIDs))
+	for i, id := range e.LaunchIDs {
+		ids[i] = strconv.FormatUint(id, 10)
+	}
+	return fmt.Sprintf(
+		"chain id %s is used by the launches %s, use one of their launch IDs instead",
+		e.ChainID,
+		strings.Join(ids, ", "),
+	)
+}
+
 // ChainLaunch fetches the chain launch from Starport Network by launch id.
 func (n Network) ChainLaunch(ctx context.Context, id uint64) (networktypes.ChainLaunch, error) {
 	n.ev.Send(events.New(events.StatusOngoing, "Fetching chain information"))
@@ -16,6 +105,10 @@ func (n Network) ChainLaunch(ctx context.Context, id uint64) (networktypes.Chain
 	res, err := launchtypes.NewQueryClient(n.cosmos.Context).Chain(ctx, &launchtypes.QueryGetChainRequest{
 		LaunchID: id,
 	})
+	n.logQuery("Chain", res, err, "launchID", id)
+	if isNotFoundError(err) {
+		return networktypes.ChainLaunch{}, LaunchNotFoundError{LaunchID: id}
+	}
 	if err != nil {
 		return networktypes.ChainLaunch{}, err
 	}
@@ -25,42 +118,183 @@ func (n Network) ChainLaunch(ctx context.Context, id uint64) (networktypes.Chain
 	return networktypes.ToChainLaunch(res.Chain), nil
 }
 
+// logQuery logs the SPN query with its arguments, its response size in bytes or its error.
+func (n Network) logQuery(method string, res interface{ Size() int }, err error, keyvals ...interface{}) {
+	keyvals = append([]interface{}{"method", method}, keyvals...)
+	if err != nil {
+		n.logger.Error("SPN query failed", append(keyvals, "err", err)...)
+		return
+	}
+	n.logger.Debug("SPN query", append(keyvals, "size", res.Size())...)
+}
+
+// isNotFoundError checks if the SPN query failed because the object doesn't exist.
+// SPN reports missing objects with an invalid argument "not found" error, which the
+// ABCI query relays with the gRPC error as its message.
+func isNotFoundError(err error) bool {
+	s, ok := status.FromError(err)
+	if !ok {
+		return false
+	}
+	return s.Code() == codes.NotFound || (s.Code() == codes.InvalidArgument && strings.Contains(s.Message(), "not found"))
+}
+
 // ChainLaunches fetches the chain launches from Starport Network
 func (n Network) ChainLaunches(ctx context.Context) ([]networktypes.ChainLaunch, error) {
 	var chainLaunches []networktypes.ChainLaunch
 
 	n.ev.Send(events.New(events.StatusOngoing, "Fetching chains information"))
-	res, err := launchtypes.NewQueryClient(n.cosmos.Context).ChainAll(ctx, &launchtypes.QueryAllChainRequest{})
+
+	// fetch all the pages since SPN limits the number of chains returned at once
+	var nextKey []byte
+	for {
+		res, err := launchtypes.NewQueryClient(n.cosmos.Context).ChainAll(ctx, &launchtypes.QueryAllChainRequest{
+			Pagination: &query.PageRequest{Key: nextKey},
+		})
+		n.logQuery("ChainAll", res, err)
+		if err != nil {
+			return chainLaunches, err
+		}
+
+		// Parse fetched chains
+		for _, chain := range res.Chain {
+			chainLaunches = append(chainLaunches, networktypes.ToChainLaunch(chain))
+		}
+
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return chainLaunches, nil
+		}
+		nextKey = res.Pagination.NextKey
+	}
+}
+
+// LaunchIDByChainID returns the ID of the only launch with the chain ID.
+func (n Network) LaunchIDByChainID(ctx context.Context, chainID string) (uint64, error) {
+	chainLaunches, err := n.ChainLaunches(ctx)
 	if err != nil {
-		return chainLaunches, err
+		return 0, err
 	}
+	return launchIDByChainID(chainLaunches, chainID)
+}
 
-	// Parse fetched chains
-	for _, chain := range res.Chain {
-		chainLaunches = append(chainLaunches, networktypes.ToChainLaunch(chain))
+// launchIDByChainID returns the ID of the only launch with the chain ID
+// or an error listing the candidates when several launches have it.
+func launchIDByChainID(chainLaunches []networktypes.ChainLaunch, chainID string) (uint64, error) {
+	var launchIDs []uint64
+	for _, chainLaunch := range chainLaunches {
+		if chainLaunch.ChainID == chainID {
+			launchIDs = append(launchIDs, chainLaunch.ID)
+		}
+	}
+	switch len(launchIDs) {
+	case 0:
+		return 0, ChainIDNotFoundError{ChainID: chainID}
+	case 1:
+		return launchIDs[0], nil
+	default:
+		return 0, AmbiguousChainIDError{ChainID: chainID, LaunchIDs: launchIDs}
 	}
+}
+
+// CoordinatorID returns the coordinator ID of the network account.
+// ErrNotCoordinator is returned if the account is not a coordinator.
+func (n Network) CoordinatorID(ctx context.Context) (uint64, error) {
+	return n.CoordinatorIDByAddress(ctx, n.account.Address(networkchain.SPN))
+}
 
-	return chainLaunches, err
+// CoordinatorIDByAddress returns the ID of the coordinator with the SPN address.
+// ErrNotCoordinator is returned if the address is not a coordinator.
+func (n Network) CoordinatorIDByAddress(ctx context.Context, address string) (uint64, error) {
+	n.ev.Send(events.New(events.StatusOngoing, "Fetching coordinator information"))
+	res, err := profiletypes.NewQueryClient(n.cosmos.Context).CoordinatorByAddress(ctx, &profiletypes.QueryGetCoordinatorByAddressRequest{
+		Address: address,
+	})
+	n.logQuery("CoordinatorByAddress", res, err, "address", address)
+	if isNotFoundError(err) {
+		return 0, ErrNotCoordinator
+	}
+	if err != nil {
+		return 0, err
+	}
+	return res.CoordinatorByAddress.CoordinatorID, nil
 }
 
-// GenesisInformation returns all the information to construct the genesis from a chain ID
-func (n Network) GenesisInformation(ctx context.Context, launchID uint64) (gi networktypes.GenesisInformation, err error) {
+// CoordinatorAddress returns the SPN address of the coordinator.
+func (n Network) CoordinatorAddress(ctx context.Context, coordinatorID uint64) (string, error) {
+	n.ev.Send(events.New(events.StatusOngoing, "Fetching coordinator address"))
+	res, err := profiletypes.NewQueryClient(n.cosmos.Context).Coordinator(ctx, &profiletypes.QueryGetCoordinatorRequest{
+		CoordinatorID: coordinatorID,
+	})
+	n.logQuery("Coordinator", res, err, "coordinatorID", coordinatorID)
+	if err != nil {
+		return "", err
+	}
+	return res.Coordinator.Address, nil
+}
+
+// Coordinator returns the profile of the coordinator with the IDs of the launches it coordinates.
+func (n Network) Coordinator(ctx context.Context, coordinatorID uint64) (networktypes.Coordinator, error) {
+	n.ev.Send(events.New(events.StatusOngoing, "Fetching coordinator profile"))
+	res, err := profiletypes.NewQueryClient(n.cosmos.Context).Coordinator(ctx, &profiletypes.QueryGetCoordinatorRequest{
+		CoordinatorID: coordinatorID,
+	})
+	n.logQuery("Coordinator", res, err, "coordinatorID", coordinatorID)
+	if isNotFoundError(err) {
+		return networktypes.Coordinator{}, CoordinatorNotFoundError{CoordinatorID: coordinatorID}
+	}
+	if err != nil {
+		return networktypes.Coordinator{}, err
+	}
+	coordinator := networktypes.ToCoordinator(res.Coordinator)
+
+	// SPN has no query of the launches by coordinator, they are filtered from all the launches
+	chainLaunches, err := n.ChainLaunches(ctx)
+	if err != nil {
+		return networktypes.Coordinator{}, err
+	}
+	coordinator.LaunchIDs = coordinatedLaunchIDs(chainLaunches, coordinatorID)
+	return coordinator, nil
+}
+
+// coordinatedLaunchIDs returns the IDs of the launches coordinated by the coordinator.
+func coordinatedLaunchIDs(chainLaunches []networktypes.ChainLaunch, coordinatorID uint64) []uint64 {
+	launchIDs := make([]uint64, 0)
+	for _, chainLaunch := range chainLaunches {
+		if chainLaunch.CoordinatorID == coordinatorID {
+			launchIDs = append(launchIDs, chainLaunch.ID)
+		}
+	}
+	return launchIDs
+}
+
+// GenesisInformation returns all the information to construct the genesis from a chain ID.
+// A GenesisInformationError is returned along with the sections queried successfully
+// when some sections can't be queried.
+func (n Network) GenesisInformation(ctx context.Context, launchID uint64) (networktypes.GenesisInformation, error) {
+	var sectionErrs GenesisInformationError
+
 	genAccs, err := n.GenesisAccounts(ctx, launchID)
 	if err != nil {
-		return gi, errors.Wrap(err, "error querying genesis accounts")
+		sectionErrs = append(sectionErrs, GenesisSectionError{Section: "genesis accounts", Err: err})
 	}
 
 	vestingAccs, err := n.VestingAccounts(ctx, launchID)
 	if err != nil {
-		return gi, errors.Wrap(err, "error querying vesting accounts")
+		// the accounts parsed before an invalid one are dropped along with it
+		vestingAccs = nil
+		sectionErrs = append(sectionErrs, GenesisSectionError{Section: "vesting accounts", Err: err})
 	}
 
 	genVals, err := n.GenesisValidators(ctx, launchID)
 	if err != nil {
-		return gi, errors.Wrap(err, "error querying genesis validators")
+		sectionErrs = append(sectionErrs, GenesisSectionError{Section: "genesis validators", Err: err})
 	}
 
-	return networktypes.NewGenesisInformation(genAccs, vestingAccs, genVals), nil
+	gi := networktypes.NewGenesisInformation(genAccs, vestingAccs, genVals)
+	if len(sectionErrs) > 0 {
+		return gi, sectionErrs
+	}
+	return gi, nil
 }
 
 // GenesisAccounts returns the list of approved genesis accounts for a launch from SPN
@@ -69,6 +303,7 @@ func (n Network) GenesisAccounts(ctx context.Context, launchID uint64) (genAccs
 	res, err := launchtypes.NewQueryClient(n.cosmos.Context).GenesisAccountAll(ctx, &launchtypes.QueryAllGenesisAccountRequest{
 		LaunchID: launchID,
 	})
+	n.logQuery("GenesisAccountAll", res, err, "launchID", launchID)
 	if err != nil {
 		return genAccs, err
 	}
@@ -80,12 +315,45 @@ func (n Network) GenesisAccounts(ctx context.Context, launchID uint64) (genAccs
 	return genAccs, nil
 }
 
+// StreamGenesisAccounts calls fn with each approved genesis account for a launch from SPN as the
+// pages of accounts are fetched, so the accounts are never all held in memory. The stream stops at the
+// first error returned by fn. No event is sent since the accounts are usually written as they arrive.
+func (n Network) StreamGenesisAccounts(
+	ctx context.Context,
+	launchID uint64,
+	fn func(networktypes.GenesisAccount) error,
+) error {
+	var nextKey []byte
+	for {
+		res, err := launchtypes.NewQueryClient(n.cosmos.Context).GenesisAccountAll(ctx, &launchtypes.QueryAllGenesisAccountRequest{
+			LaunchID:   launchID,
+			Pagination: &query.PageRequest{Key: nextKey},
+		})
+		n.logQuery("GenesisAccountAll", res, err, "launchID", launchID)
+		if err != nil {
+			return err
+		}
+
+		for _, acc := range res.GenesisAccount {
+			if err := fn(networktypes.ToGenesisAccount(acc)); err != nil {
+				return err
+			}
+		}
+
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return nil
+		}
+		nextKey = res.Pagination.NextKey
+	}
+}
+
 // VestingAccounts returns the list of approved genesis vesting accounts for a launch from SPN
 func (n Network) VestingAccounts(ctx context.Context, launchID uint64) (vestingAccs []networktypes.VestingAccount, err error) {
 	n.ev.Send(events.New(events.StatusOngoing, "Fetching genesis vesting accounts"))
 	res, err := launchtypes.NewQueryClient(n.cosmos.Context).VestingAccountAll(ctx, &launchtypes.QueryAllVestingAccountRequest{
 		LaunchID: launchID,
 	})
+	n.logQuery("VestingAccountAll", res, err, "launchID", launchID)
 	if err != nil {
 		return vestingAccs, err
 	}
@@ -102,12 +370,28 @@ func (n Network) VestingAccounts(ctx context.Context, launchID uint64) (vestingA
 	return vestingAccs, nil
 }
 
+// GenesisValidatorCount returns the number of approved genesis validators, one per gentx, for a launch
+// from SPN. Only the total is requested so the validators aren't fetched.
+func (n Network) GenesisValidatorCount(ctx context.Context, launchID uint64) (uint64, error) {
+	n.ev.Send(events.New(events.StatusOngoing, "Counting genesis validators"))
+	res, err := launchtypes.NewQueryClient(n.cosmos.Context).GenesisValidatorAll(ctx, &launchtypes.QueryAllGenesisValidatorRequest{
+		LaunchID:   launchID,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	n.logQuery("GenesisValidatorAll", res, err, "launchID", launchID)
+	if err != nil {
+		return 0, err
+	}
+	return res.Pagination.GetTotal(), nil
+}
+
 // GenesisValidators returns the list of approved genesis validators for a launch from SPN
 func (n Network) GenesisValidators(ctx context.Context, launchID uint64) (genVals []networktypes.GenesisValidator, err error) {
 	n.ev.Send(events.New(events.StatusOngoing, "Fetching genesis validators"))
 	res, err := launchtypes.NewQueryClient(n.cosmos.Context).GenesisValidatorAll(ctx, &launchtypes.QueryAllGenesisValidatorRequest{
 		LaunchID: launchID,
 	})
+	n.logQuery("GenesisValidatorAll", res, err, "launchID", launchID)
 	if err != nil {
 		return genVals, err
 	}
@@ -0,0 +1,176 @@
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/pkg/cosmosclient"
+	"github.com/tendermint/starport/starport/services/network/networktypes"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	tmlog "github.com/tendermint/tendermint/libs/log"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestIsNotFoundError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "not found code",
+			err:  status.Error(codes.NotFound, "launch"),
+			want: true,
+		},
+		{
+			name: "invalid argument not found",
+			err:  status.Error(codes.InvalidArgument, "not found"),
+			want: true,
+		},
+		{
+			name: "invalid argument not found relayed by the ABCI query",
+			err:  status.Error(codes.InvalidArgument, "rpc error: code = InvalidArgument desc = not found: invalid request"),
+			want: true,
+		},
+		{
+			name: "invalid argument",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+		{
+			name: "unavailable",
+			err:  status.Error(codes.Unavailable, "not found"),
+		},
+		{
+			name: "not a grpc error",
+			err:  errors.New("not found"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, isNotFoundError(tt.err))
+		})
+	}
+}
+
+// abciQueryMock answers the ABCI queries with the same response.
+type abciQueryMock struct {
+	rpcclient.Client
+	response abci.ResponseQuery
+}
+
+func (m abciQueryMock) ABCIQueryWithOptions(
+	context.Context,
+	string,
+	tmbytes.HexBytes,
+	rpcclient.ABCIQueryOptions,
+) (*ctypes.ResultABCIQuery, error) {
+	return &ctypes.ResultABCIQuery{Response: m.response}, nil
+}
+
+// newQueryNetwork returns a network of which the SPN queries are answered with the response.
+func newQueryNetwork(response abci.ResponseQuery) Network {
+	return Network{
+		cosmos: cosmosclient.Client{Context: client.Context{}.WithClient(abciQueryMock{response: response})},
+		logger: tmlog.NewNopLogger(),
+	}
+}
+
+// notFoundResponse is the response of SPN to a query of a missing object.
+var notFoundResponse = sdkerrors.QueryResult(
+	sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, status.Error(codes.InvalidArgument, "not found").Error()),
+)
+
+func TestCoordinatorIDByAddress(t *testing.T) {
+	n := newQueryNetwork(notFoundResponse)
+	_, err := n.CoordinatorIDByAddress(context.Background(), "spn1a")
+	require.Equal(t, ErrNotCoordinator, err)
+
+	n = newQueryNetwork(sdkerrors.QueryResult(sdkerrors.ErrUnknownRequest))
+	_, err = n.CoordinatorIDByAddress(context.Background(), "spn1a")
+	require.Error(t, err)
+	require.NotEqual(t, ErrNotCoordinator, err)
+}
+
+func TestCoordinatorNotFound(t *testing.T) {
+	n := newQueryNetwork(notFoundResponse)
+	_, err := n.Coordinator(context.Background(), 3)
+	require.Equal(t, CoordinatorNotFoundError{CoordinatorID: 3}, err)
+	require.EqualError(t, err, "coordinator id 3 not found on network")
+	require.EqualError(t, CoordinatorNotFoundError{Address: "spn1a"}, "coordinator spn1a not found on network")
+}
+
+func TestLaunchIDByChainID(t *testing.T) {
+	chainLaunches := []networktypes.ChainLaunch{
+		{ID: 1, ChainID: "mars-1"},
+		{ID: 2, ChainID: "venus-1"},
+		{ID: 3, ChainID: "venus-1"},
+	}
+
+	tests := []struct {
+		name    string
+		chainID string
+		want    uint64
+		err     error
+	}{
+		{
+			name:    "single launch",
+			chainID: "mars-1",
+			want:    1,
+		},
+		{
+			name:    "no launch",
+			chainID: "earth-1",
+			err:     ChainIDNotFoundError{ChainID: "earth-1"},
+		},
+		{
+			name:    "several launches",
+			chainID: "venus-1",
+			err:     AmbiguousChainIDError{ChainID: "venus-1", LaunchIDs: []uint64{2, 3}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := launchIDByChainID(chainLaunches, tt.chainID)
+			if tt.err != nil {
+				require.Equal(t, tt.err, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAmbiguousChainIDError(t *testing.T) {
+	err := AmbiguousChainIDError{ChainID: "venus-1", LaunchIDs: []uint64{2, 3}}
+	require.EqualError(t, err, "chain id venus-1 is used by the launches 2, 3, use one of their launch IDs instead")
+}
+
+func TestCoordinatedLaunchIDs(t *testing.T) {
+	chainLaunches := []networktypes.ChainLaunch{
+		{ID: 1, CoordinatorID: 1},
+		{ID: 2, CoordinatorID: 2},
+		{ID: 3, CoordinatorID: 1},
+	}
+	require.Equal(t, []uint64{1, 3}, coordinatedLaunchIDs(chainLaunches, 1))
+	require.Empty(t, coordinatedLaunchIDs(chainLaunches, 3))
+}
+
+func TestGenesisInformationError(t *testing.T) {
+	transient := status.Error(codes.Unavailable, "unavailable")
+	err := GenesisInformationError{
+		{Section: "genesis accounts", Err: transient},
+		{Section: "genesis validators", Err: errors.New("invalid validator")},
+	}
+	require.EqualError(t, err, "error querying genesis accounts: rpc error: code = Unavailable desc = unavailable; "+
+		"error querying genesis validators: invalid validator")
+	require.Equal(t, transient, errors.Cause(err))
+}
@@ -0,0 +1,421 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/starport/starport/pkg/cosmosutil"
+	"github.com/tendermint/starport/starport/services/network/networkchain"
+	"github.com/tendermint/starport/starport/services/network/networktypes"
+)
+
+// NotInitialized replaces the chain info fields that can't be known until the chain is initialized.
+const NotInitialized = "<not initialized>"
+
+// ChainInfo is the launch information of a chain.
+type ChainInfo struct {
+	ChainID     string
+	SourceURL   string
+	SourceHash  string
+	GenesisURL  string
+	GenesisHash string
+	HomePath    string `yaml:",redact"`
+
+	CoordinatorID      uint64
+	CoordinatorAddress string `json:",omitempty" yaml:",omitempty"`
+
+	// HashMatches is only set to false when the source checked out differs from the source hash.
+	HashMatches *bool `json:",omitempty" yaml:",omitempty"`
+
+	// the counts are only set with SetCounts since they require the genesis information.
+	AccountCount   *int `json:",omitempty" yaml:",omitempty"`
+	ValidatorCount *int `json:",omitempty" yaml:",omitempty"`
+}
+
+// ChainAccounts holds the genesis accounts of a chain.
+type ChainAccounts struct {
+	// Accounts are the genesis accounts followed by the vesting accounts with their total balance
+	// when the vesting accounts are included.
+	Accounts []networktypes.GenesisAccount
+
+	// Vesting holds the included vesting accounts by address.
+	Vesting map[string]networktypes.VestingAccount
+}
+
+// ChainPeers holds the peers of the genesis validators of a chain.
+type ChainPeers struct {
+	// Peers are the unique valid peers in the order of the validators.
+	Peers []string
+
+	// Invalid is the number of unique peers omitted because they are invalid.
+	Invalid int
+
+	// Monikers holds the moniker from the gentx of the validator of each peer.
+	Monikers map[string]string
+}
+
+// ChainInfoSummary returns the launch information of the chain, the coordinator address is left empty.
+// The chain ID and home path are set to NotInitialized when the chain isn't initialized locally.
+func ChainInfoSummary(_ context.Context, c *networkchain.Chain, chainLaunch networktypes.ChainLaunch) (ChainInfo, error) {
+	chainID, err := c.ID()
+	if err != nil || chainID == "" {
+		chainID = NotInitialized
+	}
+	home, err := c.Home()
+	if err != nil || home == "" {
+		home = NotInitialized
+	} else if ok, err := c.IsHomeDirExist(); err != nil {
+		return ChainInfo{}, err
+	} else if !ok {
+		home = NotInitialized
+	}
+
+	info := newChainInfo(chainLaunch, chainID, home)
+
+	if chainLaunch.SourceHash != "" {
+		currentHash, err := c.CurrentSourceHash()
+		if err != nil {
+			return ChainInfo{}, err
+		}
+		if !sourceHashMatches(currentHash, chainLaunch.SourceHash) {
+			matches := false
+			info.HashMatches = &matches
+		}
+	}
+	return info, nil
+}
+
+// OfflineChainInfoSummary returns the launch information of the chain from the launch record cached
+// in its home. The source hash isn't checked since the source isn't fetched offline.
+func OfflineChainInfoSummary(chainLaunch networktypes.ChainLaunch, home string) ChainInfo {
+	return newChainInfo(chainLaunch, chainLaunch.ChainID, home)
+}
+
+func newChainInfo(chainLaunch networktypes.ChainLaunch, chainID, home string) ChainInfo {
+	return ChainInfo{
+		ChainID:       chainID,
+		SourceURL:     chainLaunch.SourceURL,
+		SourceHash:    chainLaunch.SourceHash,
+		GenesisURL:    chainLaunch.GenesisURL,
+		GenesisHash:   chainLaunch.GenesisHash,
+		HomePath:      home,
+		CoordinatorID: chainLaunch.CoordinatorID,
+	}
+}
+
+// sourceHashMatches checks if the commit hash matches the source hash, which can be abbreviated.
+func sourceHashMatches(commitHash, sourceHash string) bool {
+	return strings.HasPrefix(strings.ToLower(commitHash), strings.ToLower(sourceHash))
+}
+
+// SetCounts sets the number of genesis accounts, including the vesting ones, and genesis validators.
+func (i *ChainInfo) SetCounts(gi networktypes.GenesisInformation) {
+	accounts := len(gi.GenesisAccounts) + len(gi.VestingAccounts)
+	validators := len(gi.GenesisValidators)
+	i.AccountCount, i.ValidatorCount = &accounts, &validators
+}
+
+// IsInitialized checks if the chain was initialized locally when the info was summarized.
+func (i ChainInfo) IsInitialized() bool {
+	return i.ChainID != NotInitialized && i.HomePath != NotInitialized
+}
+
+// ChainAccountsSummary returns the genesis accounts of the chain, the vesting accounts are included if withVesting.
+func ChainAccountsSummary(_ context.Context, gi networktypes.GenesisInformation, withVesting bool) ChainAccounts {
+	accounts := ChainAccounts{
+		Accounts: append([]networktypes.GenesisAccount{}, gi.GenesisAccounts...),
+		Vesting:  make(map[string]networktypes.VestingAccount),
+	}
+	if !withVesting {
+		return accounts
+	}
+
+	for _, acc := range gi.VestingAccounts {
+		accounts.Accounts = append(accounts.Accounts, networktypes.GenesisAccount{
+			Address: acc.Address,
+			Coins:   acc.TotalBalance,
+		})
+		accounts.Vesting[acc.Address] = acc
+	}
+	return accounts
+}
+
+// ChainPeersSummary returns the peers of the genesis validators of the chain.
+func ChainPeersSummary(_ context.Context, gi networktypes.GenesisInformation) ChainPeers {
+	var (
+		peers = ChainPeers{
+			Peers:    make([]string, 0),
+			Monikers: make(map[string]string),
+		}
+		seen = make(map[string]struct{})
+	)
+	for _, val := range gi.GenesisValidators {
+		if _, ok := seen[val.Peer]; ok {
+			continue
+		}
+		seen[val.Peer] = struct{}{}
+
+		if err := cosmosutil.ValidatePeer(val.Peer); err != nil {
+			peers.Invalid++
+			continue
+		}
+		peers.Peers = append(peers.Peers, val.Peer)
+
+		// the moniker is left empty if the gentx can't be parsed
+		if info, _, err := cosmosutil.ParseGentx(val.Gentx); err == nil {
+			peers.Monikers[val.Peer] = info.Moniker
+		}
+	}
+	return peers
+}
+
+// DuplicateGenesisAccounts returns the genesis accounts whose address appears more than once,
+// grouped by address in the order of their first appearance.
+func DuplicateGenesisAccounts(gi networktypes.GenesisInformation) []networktypes.GenesisAccount {
+	var (
+		count     = make(map[string]int)
+		addresses []string
+	)
+	for _, acc := range gi.GenesisAccounts {
+		if count[acc.Address] == 0 {
+			addresses = append(addresses, acc.Address)
+		}
+		count[acc.Address]++
+	}
+
+	duplicates := make([]networktypes.GenesisAccount, 0)
+	for _, address := range addresses {
+		if count[address] < 2 {
+			continue
+		}
+		for _, acc := range gi.GenesisAccounts {
+			if acc.Address == address {
+				duplicates = append(duplicates, acc)
+			}
+		}
+	}
+	return duplicates
+}
+
+// VestingDiscrepancy is a vesting account whose vesting coins don't match its balance.
+type VestingDiscrepancy struct {
+	networktypes.VestingAccount
+
+	// Issue describes the mismatch.
+	Issue string
+}
+
+// AuditVestingAccounts returns the vesting accounts whose vesting coins aren't part of their total balance
+// or whose address is also a genesis account, the genesis can't be built with such accounts.
+func AuditVestingAccounts(gi networktypes.GenesisInformation) []VestingDiscrepancy {
+	genesisAccounts := make(map[string]struct{})
+	for _, acc := range gi.GenesisAccounts {
+		genesisAccounts[acc.Address] = struct{}{}
+	}
+
+	discrepancies := make([]VestingDiscrepancy, 0)
+	for _, acc := range gi.VestingAccounts {
+		if issue := vestingIssue(acc, genesisAccounts); issue != "" {
+			discrepancies = append(discrepancies, VestingDiscrepancy{VestingAccount: acc, Issue: issue})
+		}
+	}
+	return discrepancies
+}
+
+// vestingIssue returns the mismatch between the vesting coins and the balance of the account, if any.
+func vestingIssue(acc networktypes.VestingAccount, genesisAccounts map[string]struct{}) string {
+	total, err := sdk.ParseCoinsNormalized(acc.TotalBalance)
+	if err != nil {
+		return fmt.Sprintf("invalid total balance: %s", err)
+	}
+	vesting, err := sdk.ParseCoinsNormalized(acc.Vesting)
+	if err != nil {
+		return fmt.Sprintf("invalid vesting coins: %s", err)
+	}
+	if _, ok := genesisAccounts[acc.Address]; ok {
+		return "the address is also a genesis account"
+	}
+	if vesting.IsZero() {
+		return "no vesting coins"
+	}
+	excess := sdk.NewCoins()
+	for _, coin := range vesting {
+		if diff := coin.Amount.Sub(total.AmountOf(coin.Denom)); diff.IsPositive() {
+			excess = excess.Add(sdk.NewCoin(coin.Denom, diff))
+		}
+	}
+	if !excess.IsZero() {
+		return fmt.Sprintf("the vesting coins exceed the total balance by %s", excess)
+	}
+	return ""
+}
+
+// GenesisDiff is the difference between the genesis information of a launch and a base launch.
+type GenesisDiff struct {
+	AccountsAdded     []networktypes.GenesisAccount
+	AccountsRemoved   []networktypes.GenesisAccount
+	CoinsChanged      []CoinsChange
+	ValidatorsAdded   []networktypes.GenesisValidator
+	ValidatorsRemoved []networktypes.GenesisValidator
+}
+
+// CoinsChange is a genesis account whose coins differ between two launches.
+type CoinsChange struct {
+	Address string
+	From    string
+	To      string
+}
+
+// DiffGenesisInformation returns the genesis accounts and validators added, removed or changed in gi
+// compared to base. The coins of the genesis accounts sharing an address are summed, the added and changed
+// accounts and validators are in the order of gi and the removed ones in the order of base.
+func DiffGenesisInformation(base, gi networktypes.GenesisInformation) GenesisDiff {
+	var (
+		diff                   GenesisDiff
+		baseAddresses, baseAcc = accountCoins(base.GenesisAccounts)
+		addresses, acc         = accountCoins(gi.GenesisAccounts)
+	)
+	for _, address := range addresses {
+		baseCoins, ok := baseAcc[address]
+		switch {
+		case !ok:
+			diff.AccountsAdded = append(diff.AccountsAdded, networktypes.GenesisAccount{
+				Address: address,
+				Coins:   acc[address],
+			})
+		case baseCoins != acc[address]:
+			diff.CoinsChanged = append(diff.CoinsChanged, CoinsChange{
+				Address: address,
+				From:    baseCoins,
+				To:      acc[address],
+			})
+		}
+	}
+	for _, address := range baseAddresses {
+		if _, ok := acc[address]; !ok {
+			diff.AccountsRemoved = append(diff.AccountsRemoved, networktypes.GenesisAccount{
+				Address: address,
+				Coins:   baseAcc[address],
+			})
+		}
+	}
+
+	diff.ValidatorsAdded = validatorsMissing(gi.GenesisValidators, base.GenesisValidators)
+	diff.ValidatorsRemoved = validatorsMissing(base.GenesisValidators, gi.GenesisValidators)
+	return diff
+}
+
+// accountCoins returns the unique addresses of the accounts in their order with the normalized sum
+// of their coins, the coins that can't be parsed are joined as they are.
+func accountCoins(accounts []networktypes.GenesisAccount) ([]string, map[string]string) {
+	var (
+		addresses []string
+		coins     = make(map[string]string)
+	)
+	for _, acc := range accounts {
+		total, ok := coins[acc.Address]
+		if !ok {
+			addresses = append(addresses, acc.Address)
+			coins[acc.Address] = normalizeCoins(acc.Coins)
+			continue
+		}
+		coins[acc.Address] = normalizeCoins(total + "," + acc.Coins)
+	}
+	return addresses, coins
+}
+
+// normalizeCoins returns the coins sorted with the amounts of a same denom summed,
+// the coins are returned as they are if they can't be parsed.
+func normalizeCoins(coins string) string {
+	var total sdk.Coins
+	for _, part := range strings.Split(coins, ",") {
+		parsed, err := sdk.ParseCoinsNormalized(part)
+		if err != nil {
+			return coins
+		}
+		total = total.Add(parsed...)
+	}
+	return total.String()
+}
+
+// validatorsMissing returns the validators whose address isn't used by any of the other validators.
+func validatorsMissing(validators, others []networktypes.GenesisValidator) []networktypes.GenesisValidator {
+	addresses := make(map[string]struct{})
+	for _, val := range others {
+		addresses[val.Address] = struct{}{}
+	}
+
+	var missing []networktypes.GenesisValidator
+	for _, val := range validators {
+		if _, ok := addresses[val.Address]; !ok {
+			missing = append(missing, val)
+			// the duplicated validators are only listed once
+			addresses[val.Address] = struct{}{}
+		}
+	}
+	return missing
+}
+
+// VotingPower is the distribution of the voting power among the genesis validators,
+// the power of a genesis validator is its self delegation.
+type VotingPower struct {
+	// Shares holds the share of the total self delegation of each validator in the order of the validators.
+	Shares []float64
+
+	// Controlling is the smallest number of validators holding more than a third of the voting power,
+	// these validators can halt the chain.
+	Controlling int
+}
+
+// ValidatorsVotingPower returns the voting power distribution of the genesis validators.
+// The self delegations must all be in the same denom to be compared.
+func ValidatorsVotingPower(gi networktypes.GenesisInformation) (VotingPower, error) {
+	var (
+		power   = VotingPower{Shares: make([]float64, 0, len(gi.GenesisValidators))}
+		amounts = make([]sdk.Int, 0, len(gi.GenesisValidators))
+		total   = sdk.ZeroInt()
+		denom   string
+	)
+	for _, val := range gi.GenesisValidators {
+		selfDelegation, err := sdk.ParseCoinNormalized(val.SelfDelegation)
+		if err != nil {
+			return VotingPower{}, fmt.Errorf("invalid self delegation for validator %s: %s", val.Address, err)
+		}
+		if denom == "" {
+			denom = selfDelegation.Denom
+		} else if selfDelegation.Denom != denom {
+			return VotingPower{}, fmt.Errorf("the self delegations are in several denoms: %s, %s", denom, selfDelegation.Denom)
+		}
+		amounts = append(amounts, selfDelegation.Amount)
+		total = total.Add(selfDelegation.Amount)
+	}
+	if total.IsZero() {
+		for range amounts {
+			power.Shares = append(power.Shares, 0)
+		}
+		return power, nil
+	}
+
+	totalFloat := new(big.Float).SetInt(total.BigInt())
+	for _, amount := range amounts {
+		share, _ := new(big.Float).Quo(new(big.Float).SetInt(amount.BigInt()), totalFloat).Float64()
+		power.Shares = append(power.Shares, share)
+	}
+
+	// the largest validators are added until they hold more than a third of the total
+	sorted := append([]sdk.Int{}, amounts...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].GT(sorted[j]) })
+	held := sdk.ZeroInt()
+	for _, amount := range sorted {
+		held = held.Add(amount)
+		power.Controlling++
+		if held.MulRaw(3).GT(total) {
+			break
+		}
+	}
+	return power, nil
+}
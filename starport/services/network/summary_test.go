@@ -0,0 +1,228 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/services/network/networktypes"
+)
+
+func TestChainAccountsSummary(t *testing.T) {
+	gi := networktypes.GenesisInformation{
+		GenesisAccounts: []networktypes.GenesisAccount{
+			{Address: "spn1foo", Coins: "10stake"},
+		},
+		VestingAccounts: []networktypes.VestingAccount{
+			{Address: "spn1bar", TotalBalance: "20stake", Vesting: "5stake", EndTime: 100},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		withVesting bool
+		want        ChainAccounts
+	}{
+		{
+			name: "without vesting",
+			want: ChainAccounts{
+				Accounts: []networktypes.GenesisAccount{{Address: "spn1foo", Coins: "10stake"}},
+				Vesting:  map[string]networktypes.VestingAccount{},
+			},
+		},
+		{
+			name:        "with vesting",
+			withVesting: true,
+			want: ChainAccounts{
+				Accounts: []networktypes.GenesisAccount{
+					{Address: "spn1foo", Coins: "10stake"},
+					{Address: "spn1bar", Coins: "20stake"},
+				},
+				Vesting: map[string]networktypes.VestingAccount{
+					"spn1bar": gi.VestingAccounts[0],
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ChainAccountsSummary(context.Background(), gi, tt.withVesting)
+			require.Equal(t, tt.want, got)
+			require.Len(t, gi.GenesisAccounts, 1, "should not modify the genesis information")
+		})
+	}
+}
+
+func TestChainPeersSummary(t *testing.T) {
+	const (
+		peer1 = "2b3ac3f4f7f3e1a9e9c3f2c1a1b2c3d4e5f6a7b8@1.2.3.4:26656"
+		peer2 = "3b3ac3f4f7f3e1a9e9c3f2c1a1b2c3d4e5f6a7b8@5.6.7.8:26656"
+	)
+	gi := networktypes.GenesisInformation{
+		GenesisValidators: []networktypes.GenesisValidator{
+			{Address: "spn1a", Peer: peer1, Gentx: []byte(`{"body":{"messages":[{"description":{"moniker":"alice"},"value":{"denom":"stake","amount":"1"}}]}}`)},
+			{Address: "spn1b", Peer: "invalid"},
+			{Address: "spn1c", Peer: peer2},
+			{Address: "spn1d", Peer: peer1},
+			{Address: "spn1e", Peer: "invalid"},
+		},
+	}
+
+	got := ChainPeersSummary(context.Background(), gi)
+	require.Equal(t, ChainPeers{
+		Peers:    []string{peer1, peer2},
+		Invalid:  1,
+		Monikers: map[string]string{peer1: "alice"},
+	}, got)
+}
+
+func TestChainInfoSetCounts(t *testing.T) {
+	var info ChainInfo
+	info.SetCounts(networktypes.GenesisInformation{
+		GenesisAccounts:   []networktypes.GenesisAccount{{Address: "spn1foo"}, {Address: "spn1bar"}},
+		VestingAccounts:   []networktypes.VestingAccount{{Address: "spn1baz"}},
+		GenesisValidators: []networktypes.GenesisValidator{{Address: "spn1foo"}},
+	})
+	require.Equal(t, 3, *info.AccountCount)
+	require.Equal(t, 1, *info.ValidatorCount)
+}
+
+func TestSourceHashMatches(t *testing.T) {
+	const commitHash = "4ca78a240c57f1e3bbd0e8a3f6f0b1a2c3d4e5f6"
+
+	tests := []struct {
+		name       string
+		sourceHash string
+		want       bool
+	}{
+		{name: "full hash", sourceHash: commitHash, want: true},
+		{name: "abbreviated hash", sourceHash: "4ca78a2", want: true},
+		{name: "uppercase hash", sourceHash: "4CA78A2", want: true},
+		{name: "other hash", sourceHash: "5ca78a2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, sourceHashMatches(commitHash, tt.sourceHash))
+		})
+	}
+}
+
+func TestDuplicateGenesisAccounts(t *testing.T) {
+	gi := networktypes.GenesisInformation{
+		GenesisAccounts: []networktypes.GenesisAccount{
+			{Address: "spn1foo", Coins: "10stake"},
+			{Address: "spn1bar", Coins: "20stake"},
+			{Address: "spn1baz", Coins: "30stake"},
+			{Address: "spn1bar", Coins: "40stake"},
+			{Address: "spn1foo", Coins: "50stake"},
+		},
+	}
+	require.Equal(t, []networktypes.GenesisAccount{
+		{Address: "spn1foo", Coins: "10stake"},
+		{Address: "spn1foo", Coins: "50stake"},
+		{Address: "spn1bar", Coins: "20stake"},
+		{Address: "spn1bar", Coins: "40stake"},
+	}, DuplicateGenesisAccounts(gi))
+
+	require.Empty(t, DuplicateGenesisAccounts(networktypes.GenesisInformation{}))
+}
+
+func TestAuditVestingAccounts(t *testing.T) {
+	gi := networktypes.GenesisInformation{
+		GenesisAccounts: []networktypes.GenesisAccount{
+			{Address: "spn1dup", Coins: "10stake"},
+		},
+		VestingAccounts: []networktypes.VestingAccount{
+			{Address: "spn1foo", TotalBalance: "100stake,10token", Vesting: "50stake"},
+			{Address: "spn1bar", TotalBalance: "100stake", Vesting: "150stake,5token"},
+			{Address: "spn1dup", TotalBalance: "100stake", Vesting: "50stake"},
+			{Address: "spn1baz", TotalBalance: "100stake", Vesting: ""},
+			{Address: "spn1qux", TotalBalance: "invalid!", Vesting: "50stake"},
+		},
+	}
+
+	got := AuditVestingAccounts(gi)
+	require.Len(t, got, 4)
+	require.Equal(t, "spn1bar", got[0].Address)
+	require.Equal(t, "the vesting coins exceed the total balance by 50stake,5token", got[0].Issue)
+	require.Equal(t, "the address is also a genesis account", got[1].Issue)
+	require.Equal(t, "no vesting coins", got[2].Issue)
+	require.Equal(t, "spn1qux", got[3].Address)
+	require.True(t, strings.HasPrefix(got[3].Issue, "invalid total balance"))
+
+	require.Empty(t, AuditVestingAccounts(networktypes.GenesisInformation{}))
+}
+
+func TestDiffGenesisInformation(t *testing.T) {
+	base := networktypes.GenesisInformation{
+		GenesisAccounts: []networktypes.GenesisAccount{
+			{Address: "spn1foo", Coins: "10stake"},
+			{Address: "spn1bar", Coins: "10stake"},
+			{Address: "spn1baz", Coins: "5token,10stake"},
+			{Address: "spn1qux", Coins: "10stake"},
+		},
+		GenesisValidators: []networktypes.GenesisValidator{
+			{Address: "spn1foo", SelfDelegation: "10stake"},
+			{Address: "spn1bar", SelfDelegation: "10stake"},
+		},
+	}
+	gi := networktypes.GenesisInformation{
+		GenesisAccounts: []networktypes.GenesisAccount{
+			{Address: "spn1new", Coins: "1stake"},
+			{Address: "spn1foo", Coins: "5stake"},
+			{Address: "spn1foo", Coins: "5stake"},
+			{Address: "spn1baz", Coins: "10stake,5token"},
+			{Address: "spn1qux", Coins: "20stake"},
+		},
+		GenesisValidators: []networktypes.GenesisValidator{
+			{Address: "spn1foo", SelfDelegation: "20stake"},
+			{Address: "spn1new", SelfDelegation: "1stake"},
+			{Address: "spn1new", SelfDelegation: "1stake"},
+		},
+	}
+
+	got := DiffGenesisInformation(base, gi)
+	require.Equal(t, []networktypes.GenesisAccount{{Address: "spn1new", Coins: "1stake"}}, got.AccountsAdded)
+	require.Equal(t, []networktypes.GenesisAccount{{Address: "spn1bar", Coins: "10stake"}}, got.AccountsRemoved)
+	require.Equal(t, []CoinsChange{{Address: "spn1qux", From: "10stake", To: "20stake"}}, got.CoinsChanged)
+	require.Equal(t, []networktypes.GenesisValidator{{Address: "spn1new", SelfDelegation: "1stake"}}, got.ValidatorsAdded)
+	require.Equal(t, []networktypes.GenesisValidator{{Address: "spn1bar", SelfDelegation: "10stake"}}, got.ValidatorsRemoved)
+
+	require.Equal(t, GenesisDiff{}, DiffGenesisInformation(gi, gi))
+}
+
+func TestValidatorsVotingPower(t *testing.T) {
+	validators := func(selfDelegations ...string) networktypes.GenesisInformation {
+		var gi networktypes.GenesisInformation
+		for i, selfDelegation := range selfDelegations {
+			gi.GenesisValidators = append(gi.GenesisValidators, networktypes.GenesisValidator{
+				Address:        fmt.Sprintf("spn1val%d", i),
+				SelfDelegation: selfDelegation,
+			})
+		}
+		return gi
+	}
+
+	got, err := ValidatorsVotingPower(validators("10stake", "50stake", "20stake", "20stake"))
+	require.NoError(t, err)
+	require.Equal(t, []float64{0.1, 0.5, 0.2, 0.2}, got.Shares)
+	require.Equal(t, 1, got.Controlling)
+
+	// a third exactly doesn't control the chain
+	got, err = ValidatorsVotingPower(validators("10stake", "10stake", "10stake"))
+	require.NoError(t, err)
+	require.Equal(t, 2, got.Controlling)
+
+	got, err = ValidatorsVotingPower(networktypes.GenesisInformation{})
+	require.NoError(t, err)
+	require.Empty(t, got.Shares)
+	require.Zero(t, got.Controlling)
+
+	_, err = ValidatorsVotingPower(validators("10stake", "10token"))
+	require.EqualError(t, err, "the self delegations are in several denoms: stake, token")
+
+	_, err = ValidatorsVotingPower(validators("invalid!"))
+	require.Error(t, err)
+}